@@ -9,6 +9,43 @@ import (
 var appAction cli.ActionFunc = func(c *cli.Context) error {
 	lg.Debug()
 
+	opt.setLogLevel()
+
+	opt.vopt = vfs.NewVFSOption()
+
+	opt.checkArgs(c)
+
+	opt.checkOptions()
+	defer opt.out.Close()
+
+	// View
+	if opt.isNull {
+		err := opt.viewNull()
+		if err != nil {
+			stderrf("view: %s", err.Error())
+		}
+	} else if len(opt.paths) < 1 {
+		err := opt.view()
+		if err != nil {
+			stderrf("view: %s", err.Error())
+		}
+	} else if isAllDirs(opt.paths) {
+		err := opt.viewRoots()
+		if err != nil {
+			stderrf("view: %s", err.Error())
+		}
+	} else {
+		err := opt.viewPaths()
+		if err != nil {
+			stderrf("view: %s", err.Error())
+		}
+	}
+
+	return nil
+}
+
+// setLogLevel sets the level of logging by --info, --debug and --trace
+func (opt *option) setLogLevel() {
 	lg.SetLevel(logrus.WarnLevel)
 
 	if opt.isInfo {
@@ -22,10 +59,22 @@ var appAction cli.ActionFunc = func(c *cli.Context) error {
 	if opt.isTrace {
 		lg.SetLevel(logrus.TraceLevel)
 	}
+}
 
-	opt.vopt = vfs.NewVFSOption()
+// checkOptions checks all options except the arguments, and sets up opt.vopt
+func (opt *option) checkOptions() {
+	// Color
+	opt.checkColor()
+	opt.checkHyperlink()
 
-	opt.checkArgs(c)
+	// Pager
+	opt.checkPaging()
+
+	// Quoting
+	opt.checkQuoting()
+
+	// Theme (colors)
+	opt.checkTheme()
 
 	// ViewType (during view)
 	opt.checkViewType()
@@ -41,19 +90,4 @@ var appAction cli.ActionFunc = func(c *cli.Context) error {
 
 	// Setuo vfs.VFSOption
 	opt.setVFSOption()
-
-	// View
-	if len(opt.paths) < 1 {
-		err := opt.view()
-		if err != nil {
-			stderrf("view: %s", err.Error())
-		}
-	} else {
-		err := opt.viewPaths()
-		if err != nil {
-			stderrf("view: %s", err.Error())
-		}
-	}
-
-	return nil
 }
@@ -28,11 +28,18 @@ var (
 	}
 	fg_sortByField = &cli.StringFlag{
 		Name:        "sortby",
-		Aliases:     []string{"f"},
+		Aliases:     []string{"f", "sort"},
 		Value:       "",
-		Usage:       "which single `field` to sort by. (case insensitive,field: inode, links, blocks, size, mtime (ot modified), atime (or accessed), ctime (or created), name, lname (lower name, default); «field»[r|R]: reverse sort)",
+		Usage:       "which `fields` to sort by, separated by commas for multi-key sorting, e.g. ext,size:desc,name. (case insensitive, field: inode, links, blocks, size, mtime (or modified), atime (or accessed), ctime (or created), name, lname (lower name, default), mime, lines, user, group, md5, ext (or extension), git, version (or natural), collate (or locale), pinyin, zhuyin (or bopomofo), stroke; «field»:desc or «field»[r|R]: reverse sort)",
 		Destination: &opt.sortByField,
 	}
+	fg_collate = &cli.StringFlag{
+		Name:        "collate",
+		Aliases:     []string{"co"},
+		Value:       "",
+		Usage:       "sort by name in the collation order of `locale`, e.g. zh-TW, zh_TW.UTF-8, ja or auto (LC_ALL, LC_COLLATE or LANG), optionally followed by :pinyin, :zhuyin or :stroke for the order of Han characters, e.g. zh-TW:pinyin",
+		Destination: &opt.collate,
+	}
 	fg_isSortByName = &cli.BoolFlag{
 		Name:        "byname",
 		Aliases:     []string{"bn"},
@@ -111,6 +118,41 @@ var (
 		Usage:       "sort by md5 string in increasing order (single key)",
 		Destination: &opt.isSortByMd5,
 	}
+	fg_isSortByMime = &cli.BoolFlag{
+		Name:        "bymime",
+		Aliases:     []string{"bt"},
+		Value:       false,
+		Usage:       "sort by MIME type detected by content in increasing order (single key)",
+		Destination: &opt.isSortByMime,
+	}
+	fg_isSortByLines = &cli.BoolFlag{
+		Name:        "bylines",
+		Aliases:     []string{"bli"},
+		Value:       false,
+		Usage:       "sort by line count of text files in increasing order (single key)",
+		Destination: &opt.isSortByLines,
+	}
+	fg_isSortByExt = &cli.BoolFlag{
+		Name:        "byext",
+		Aliases:     []string{"be"},
+		Value:       false,
+		Usage:       "sort by extension of name in increasing order, directories first (single key)",
+		Destination: &opt.isSortByExt,
+	}
+	fg_isSortByGit = &cli.BoolFlag{
+		Name:        "bygit",
+		Aliases:     []string{"bgt"},
+		Value:       false,
+		Usage:       "sort by git status: unmerged, changed, untracked, unmodified and ignored (single key)",
+		Destination: &opt.isSortByGit,
+	}
+	fg_isSortByVersion = &cli.BoolFlag{
+		Name:        "byversion",
+		Aliases:     []string{"bv"},
+		Value:       false,
+		Usage:       "sort by name in natural (version) order, e.g. file2 before file10 (single key)",
+		Destination: &opt.isSortByVersion,
+	}
 
 	cmd_ByField = &cli.Command{
 		Name:    "sort",
@@ -122,13 +164,14 @@ var (
 			fg_isSortByINode, fg_isSortBySize, fg_isSortByHDLinks, fg_isSortByBlocks,
 			fg_isSortByUser, fg_isSortByGroup,
 			fg_isSortByMTime, fg_isSortByATime, fg_isSortByCTime,
-			fg_isSortByMd5,
+			fg_isSortByMd5, fg_isSortByMime, fg_isSortByLines,
+			fg_isSortByExt, fg_isSortByGit, fg_isSortByVersion, fg_collate,
 		},
 		Subcommands: []*cli.Command{
 			{
 				Name:    "field",
 				Aliases: []string{"f"},
-				Usage:   "which single `field` to sort by. (case insensitive,field: inode, links, blocks, size, mtime (ot modified), atime (or accessed), ctime (or created), name, lname (lower name, default); «field»[r|R]: reverse sort)",
+				Usage:   "which `fields` to sort by, separated by commas for multi-key sorting, e.g. ext,size:desc,name. (case insensitive, field: inode, links, blocks, size, mtime (or modified), atime (or accessed), ctime (or created), name, lname (lower name, default), mime, lines, user, group, md5, ext (or extension), git, version (or natural); «field»:desc or «field»[r|R]: reverse sort)",
 				Action: func(c *cli.Context) error {
 					opt.sortByField = c.Args().First()
 					return appAction(c)
@@ -175,7 +218,7 @@ var (
 					fg_isSortReverse,
 				},
 				Action: func(c *cli.Context) error {
-					opt.isSortByINode = true
+					opt.isSortByHDLinks = true
 					return appAction(c)
 				},
 			},
@@ -223,7 +266,7 @@ var (
 					fg_isSortReverse,
 				},
 				Action: func(c *cli.Context) error {
-					opt.isSortByINode = true
+					opt.isSortByGroup = true
 					return appAction(c)
 				},
 			},
@@ -275,6 +318,66 @@ var (
 					return appAction(c)
 				},
 			},
+			{
+				Name:    "mime",
+				Aliases: []string{"t"},
+				Usage:   "sort by MIME type detected by content in increasing order (single key)",
+				Flags: []cli.Flag{
+					fg_isSortReverse,
+				},
+				Action: func(c *cli.Context) error {
+					opt.isSortByMime = true
+					return appAction(c)
+				},
+			},
+			{
+				Name:    "lines",
+				Aliases: []string{"L"},
+				Usage:   "sort by line count of text files in increasing order (single key)",
+				Flags: []cli.Flag{
+					fg_isSortReverse,
+				},
+				Action: func(c *cli.Context) error {
+					opt.isSortByLines = true
+					return appAction(c)
+				},
+			},
+			{
+				Name:    "ext",
+				Aliases: []string{"e"},
+				Usage:   "sort by extension of name in increasing order, directories first (single key)",
+				Flags: []cli.Flag{
+					fg_isSortReverse,
+				},
+				Action: func(c *cli.Context) error {
+					opt.isSortByExt = true
+					return appAction(c)
+				},
+			},
+			{
+				Name:    "git",
+				Aliases: []string{"G"},
+				Usage:   "sort by git status: unmerged, changed, untracked, unmodified and ignored (single key)",
+				Flags: []cli.Flag{
+					fg_isSortReverse,
+				},
+				Action: func(c *cli.Context) error {
+					opt.isSortByGit = true
+					return appAction(c)
+				},
+			},
+			{
+				Name:    "version",
+				Aliases: []string{"v"},
+				Usage:   "sort by name in natural (version) order, e.g. file2 before file10 (single key)",
+				Flags: []cli.Flag{
+					fg_isSortReverse,
+				},
+				Action: func(c *cli.Context) error {
+					opt.isSortByVersion = true
+					return appAction(c)
+				},
+			},
 		},
 		Action: appAction,
 	}
@@ -292,10 +395,6 @@ func (opt *option) checkByField() {
 	}
 
 	// opt.byField = vfs.SortByLowerName
-	sflag = strings.ToLower(opt.sortByField)
-	if len(sflag) == 0 {
-		sflag = "lname"
-	}
 	if opt.isSortByINode {
 		sflag = "inode"
 	}
@@ -317,12 +416,55 @@ func (opt *option) checkByField() {
 	if opt.isSortByCTime {
 		sflag = "ctime"
 	}
+	if opt.isSortByUser {
+		sflag = "user"
+	}
+	if opt.isSortByGroup {
+		sflag = "group"
+	}
 	if opt.isSortByMd5 {
 		sflag = "md5"
 	}
+	if opt.isSortByMime {
+		sflag = "mime"
+	}
+	if opt.isSortByLines {
+		sflag = "lines"
+	}
+	if opt.isSortByExt {
+		sflag = "ext"
+	}
+	if opt.isSortByGit {
+		sflag = "git"
+	}
+	if opt.isSortByVersion {
+		sflag = "version"
+	}
 	if opt.isSortByName {
 		sflag = "name"
 	}
+
+	// sort keys, e.g. --sort natural or --sort ext,size:desc,name (the flags of sorting by a field take precedence)
+	if len(sflag) == 0 && len(opt.sortByField) > 0 {
+		keys, err := vfs.ParseSortKeys(opt.sortByField)
+		if err != nil {
+			warningf("%v, use default sort field: lname\n", err)
+		} else {
+			if opt.isSortReverse {
+				for i := range keys {
+					keys[i] ^= vfs.SortReverse
+				}
+			}
+			opt.sortKeys = keys
+			opt.byField = keys[0]
+			opt.viewFields = keys.ViewFields()
+			goto END
+		}
+	}
+
+	if len(sflag) == 0 {
+		sflag = "lname"
+	}
 	if opt.isSortReverse {
 		sflag += "r"
 	}
@@ -339,5 +481,59 @@ func (opt *option) checkByField() {
 	opt.viewFields = vfs.SortKey2ViewField[opt.byField]
 
 END:
-	info(paw.NewValuePair("Sort", opt.byField))
+	opt.checkCollate()
+
+	info(paw.ValuePairA([]*paw.ValuePair{
+		paw.NewValuePair("Sort", opt.byField),
+		paw.NewValuePair("Sort keys", opt.sortKeys),
+		paw.NewValuePair("Collate", opt.collate),
+	}))
+}
+
+// collateOrderKeys are the sort keys of the orders of Han characters of --collate
+var collateOrderKeys = map[string]vfs.SortKey{
+	"":       vfs.SortByCollate,
+	"pinyin": vfs.SortByPinyin,
+	"zhuyin": vfs.SortByZhuyin,
+	"stroke": vfs.SortByStroke,
+}
+
+// checkCollate sets the locale of collation of opt.collate ("locale[:order]"), and replaces the keys of sorting by name with the key of collation
+func (opt *option) checkCollate() {
+	if len(opt.collate) == 0 && cu != nil {
+		opt.collate = cu.Collate
+	}
+	if len(opt.collate) == 0 {
+		return
+	}
+
+	locale, order := opt.collate, ""
+	if i := strings.LastIndexByte(locale, ':'); i >= 0 {
+		locale, order = locale[:i], strings.ToLower(locale[i+1:])
+	}
+	key, ok := collateOrderKeys[order]
+	if !ok {
+		warningf("unknown order of Han characters %q of --collate, use one of pinyin, zhuyin or stroke\n", order)
+		key = vfs.SortByCollate
+	}
+	if strings.EqualFold(locale, "auto") {
+		locale = ""
+	}
+	if err := vfs.SetCollation(locale); err != nil {
+		warningf("%v, use the order of code points\n", err)
+		opt.collate = ""
+		return
+	}
+
+	collated := func(k vfs.SortKey) vfs.SortKey {
+		switch k &^ vfs.SortReverse {
+		case vfs.SortByName, vfs.SortByLowerName:
+			return key | k&vfs.SortReverse
+		}
+		return k
+	}
+	opt.byField = collated(opt.byField)
+	for i, k := range opt.sortKeys {
+		opt.sortKeys[i] = collated(k)
+	}
 }
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"os"
+
+	"github.com/shyang107/paw"
+	"github.com/urfave/cli"
+)
+
+var (
+	// -------------------------------------------
+	// Color
+	fg_color = &cli.StringFlag{
+		Name:        "color",
+		Aliases:     []string{},
+		Value:       "auto",
+		Usage:       "colorize the output `when`: auto (only to terminal, respecting NO_COLOR, CLICOLOR and CLICOLOR_FORCE), always or never",
+		Destination: &opt.color,
+	}
+	fg_hyperlink = &cli.StringFlag{
+		Name:        "hyperlink",
+		Aliases:     []string{"hl"},
+		Value:       "auto",
+		Usage:       "hyperlink file names (OSC 8, file://host/path) `when`: auto (only to terminal supporting them, or FORCE_HYPERLINK), always or never; hyperlinks are removed with colors",
+		Destination: &opt.hyperlink,
+	}
+)
+
+func (opt *option) checkColor() {
+	lg.Debug(paw.Caller(1))
+
+	mode, err := paw.ParseColorMode(opt.color)
+	if err != nil {
+		warningf("%v, use \"auto\"\n", err)
+	}
+	opt.colorMode = mode
+	setLogOutputs(mode)
+
+	info(paw.ValuePairA([]*paw.ValuePair{
+		paw.NewValuePair("Color", opt.colorMode),
+		paw.NewValuePair("Color depth", paw.TermColorDepth),
+	}))
+}
+
+// setLogOutputs sets the outputs of loggers, which are colored under mode
+func setLogOutputs(mode paw.ColorMode) {
+	stdout := paw.NewColorWriter(os.Stdout, mode)
+	stderr := paw.NewColorWriter(os.Stderr, mode)
+	paw.GologInit(stdout, stderr, stderr, false)
+	lg.SetOutput(stdout)
+}
+
+func (opt *option) checkHyperlink() {
+	lg.Debug(paw.Caller(1))
+
+	mode, err := paw.ParseHyperlinkMode(opt.hyperlink)
+	if err != nil {
+		warningf("%v, use \"auto\"\n", err)
+	}
+	opt.hyperlinkMode = mode
+
+	if mode.IsHyperlinkWriter(os.Stdout) {
+		paw.EnableHyperlink()
+	} else {
+		paw.DisableHyperlink()
+	}
+
+	info(paw.NewValuePair("Hyperlink", opt.hyperlinkMode))
+}
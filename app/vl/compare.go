@@ -0,0 +1,129 @@
+package main
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/shyang107/paw"
+	"github.com/shyang107/paw/vfs"
+	"github.com/urfave/cli"
+)
+
+var (
+	// -------------------------------------------
+	// Compare
+	fg_isHideIdentical = &cli.BoolFlag{
+		Name:        "hide-identical",
+		Aliases:     []string{"hi"},
+		Value:       false,
+		Usage:       "hide the identical entries, and the directories whose entries are all identical",
+		Destination: &opt.isHideIdentical,
+	}
+	fg_isCompareChecksum = &cli.BoolFlag{
+		Name:        "checksum",
+		Aliases:     []string{"ck"},
+		Value:       false,
+		Usage:       "compare md5 checksums of the files of the same size (slow for large files)",
+		Destination: &opt.isCompareChecksum,
+	}
+	fg_isIgnoreMTime = &cli.BoolFlag{
+		Name:        "ignore-mtime",
+		Aliases:     []string{"it"},
+		Value:       false,
+		Usage:       "do not compare modified times of files",
+		Destination: &opt.isIgnoreMTime,
+	}
+	fg_isIgnoreMode = &cli.BoolFlag{
+		Name:        "ignore-mode",
+		Aliases:     []string{"im"},
+		Value:       false,
+		Usage:       "do not compare permissions",
+		Destination: &opt.isIgnoreMode,
+	}
+
+	cmd_Compare = &cli.Command{
+		Name:      "compare",
+		Aliases:   []string{"cmp"},
+		Usage:     "compare two directories recursively side by side, aligned by relative path (marks: = identical, < only in A, > only in B, ≠ different)",
+		ArgsUsage: "A B",
+		Flags: []cli.Flag{
+			fg_isHideIdentical, fg_isCompareChecksum, fg_isIgnoreMTime, fg_isIgnoreMode,
+			// Depth
+			fg_Depth, fg_IsFindRecurse, fg_isForceRecurse,
+			// Symlink
+			fg_followMode, fg_isDereference,
+			// SkipConds
+			fg_isNoSkip, fg_reIncludePattern, fg_reExcludePattern,
+			fg_withNoPrefix, fg_withNoSufix, fg_psDelimiter,
+			// Color
+			fg_color, fg_hyperlink,
+			// Theme
+			fg_theme, fg_dircolors,
+			// Pager
+			fg_paging,
+			// Quoting
+			fg_quotingStyle,
+		},
+		Action: compareAction,
+	}
+)
+
+var compareAction cli.ActionFunc = func(c *cli.Context) error {
+	lg.Debug()
+
+	opt.setLogLevel()
+
+	opt.vopt = vfs.NewVFSOption()
+
+	if c.NArg() != 2 {
+		fatalf("compare: need two directories, A and B, but got %d arguments\n", c.NArg())
+	}
+	roots := make([]string, 0, 2)
+	for _, arg := range c.Args().Slice() {
+		path, err := filepath.Abs(arg)
+		if err == nil {
+			_, err = os.Stat(path)
+		}
+		if err != nil {
+			fatal(&fs.PathError{
+				Op:   "compare",
+				Path: arg,
+				Err:  err,
+			})
+		}
+		roots = append(roots, path)
+	}
+	opt.rootPath = roots[0]
+	info(paw.NewValuePair("Compare", roots))
+
+	// compare recursively (like as `diff -r`), unless --depth is given
+	if !c.IsSet("depth") {
+		opt.depth = -1
+	}
+
+	opt.checkOptions()
+	defer opt.out.Close()
+
+	vfss := make([]*vfs.VFS, 0, 2)
+	for _, root := range roots {
+		vopt := *opt.vopt
+		v, err := vfs.NewVFS(root, &vopt)
+		if err != nil {
+			fatal(err)
+		}
+		if err := v.BuildFS(); err != nil {
+			fatal(err)
+		}
+		vfss = append(vfss, v)
+	}
+
+	cmp := vfs.CompareVFS(vfss[0], vfss[1], &vfs.CompareOption{
+		IsChecksum:      opt.isCompareChecksum,
+		IsIgnoreMTime:   opt.isIgnoreMTime,
+		IsIgnoreMode:    opt.isIgnoreMode,
+		IsHideIdentical: opt.isHideIdentical,
+	})
+	cmp.View(opt.out)
+	return nil
+}
@@ -0,0 +1,473 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/shyang107/paw"
+	"github.com/shyang107/paw/vfs"
+	"github.com/urfave/cli"
+)
+
+var (
+	// -------------------------------------------
+	// Completion
+	cmd_Completion = &cli.Command{
+		Name:      "completion",
+		Usage:     "print the script of shell completion, e.g. `source <(vl completion bash)`, `vl completion zsh > ~/.zfunc/_vl` or `vl completion fish > ~/.config/fish/completions/vl.fish`",
+		ArgsUsage: "bash|zsh|fish",
+		Action:    completionAction,
+	}
+)
+
+var completionAction cli.ActionFunc = func(c *cli.Context) error {
+	lg.Debug()
+
+	root := newCompCommand(c.App.Name, c.App.Usage, c.App.Flags, c.App.Commands)
+	switch shell := c.Args().First(); shell {
+	case "bash":
+		writeBashCompletion(os.Stdout, root)
+	case "zsh":
+		writeZshCompletion(os.Stdout, root)
+	case "fish":
+		writeFishCompletion(os.Stdout, root)
+	default:
+		fatalf("completion: unknown shell %q, use bash, zsh or fish\n", shell)
+	}
+	return nil
+}
+
+// compCommand is a command of the cli definitions for completion
+type compCommand struct {
+	path  string // e.g. "vl sort"
+	names []string
+	usage string
+	flags []*compFlag
+	subs  []*compCommand
+}
+
+// compFlag is a flag of the cli definitions for completion
+type compFlag struct {
+	names      []string // e.g. "--sortby", "-f"
+	usage      string
+	takesValue bool
+	isComma    bool     // values separated by commas
+	isFile     bool     // a path of file (besides values)
+	values     []string // the values to complete
+}
+
+func newCompCommand(path, usage string, flags []cli.Flag, cmds []*cli.Command) *compCommand {
+	c := &compCommand{
+		path:  path,
+		names: []string{filepath.Base(path)},
+		usage: usage,
+	}
+	for _, f := range flags {
+		c.flags = append(c.flags, newCompFlag(f))
+	}
+	for _, cmd := range cmds {
+		if cmd.Hidden {
+			continue
+		}
+		sub := newCompCommand(path+" "+cmd.Name, cmd.Usage, cmd.Flags, cmd.Subcommands)
+		sub.names = cmd.Names()
+		c.subs = append(c.subs, sub)
+	}
+	return c
+}
+
+func newCompFlag(f cli.Flag) *compFlag {
+	cf := &compFlag{}
+	for _, name := range f.Names() {
+		if len(name) == 1 {
+			cf.names = append(cf.names, "-"+name)
+		} else {
+			cf.names = append(cf.names, "--"+name)
+		}
+	}
+	if df, ok := f.(interface {
+		TakesValue() bool
+		GetUsage() string
+	}); ok {
+		cf.takesValue = df.TakesValue()
+		cf.usage = df.GetUsage()
+	}
+	if cf.takesValue {
+		cf.values, cf.isComma, cf.isFile = completionValues(f.Names()[0])
+	}
+	return cf
+}
+
+// completionValues returns the values of the flag `name` to complete, whether they are separated by commas, and whether the value may be a path of file
+func completionValues(name string) (values []string, isComma, isFile bool) {
+	switch name {
+	case "sortby":
+		for key := range vfs.SortShortNameKeys {
+			values = append(values, key)
+		}
+		for alias := range vfs.SortKeyAliases {
+			values = append(values, alias)
+		}
+		isComma = true
+	case "grid-field":
+		for key := range vfs.GridFieldShortNames {
+			values = append(values, key)
+		}
+	case "view":
+		values = vfs.ViewNames()
+	case "format":
+		values = []string{"text", "md", "markdown", "html", "svg"}
+	case "groupby":
+		for key := range vfs.GroupNames {
+			values = append(values, key)
+		}
+	case "follow":
+		for _, name := range vfs.FollowModeNames {
+			values = append(values, name)
+		}
+	case "color", "hyperlink", "paging":
+		values = []string{"auto", "always", "never"}
+	case "quoting-style":
+		for _, name := range vfs.QuotingStyleNames {
+			values = append(values, name)
+		}
+	case "collate":
+		for order := range collateOrderKeys {
+			if len(order) == 0 {
+				values = append(values, "auto")
+			} else {
+				values = append(values, "auto:"+order)
+			}
+		}
+	case "theme":
+		values = append(values, paw.ThemeNames()...)
+		values = append(values, userThemeNames()...)
+		isFile = true
+	case "dircolors":
+		isFile = true
+	}
+	sort.Strings(values)
+	return values, isComma, isFile
+}
+
+// userThemeNames returns the names of theme files in the directory of themes of user (e.g. `~/.config/vl/themes`)
+func userThemeNames() (names []string) {
+	path := userConfigPath()
+	if len(path) == 0 {
+		return nil
+	}
+	des, err := os.ReadDir(filepath.Join(filepath.Dir(path), "themes"))
+	if err != nil {
+		return nil
+	}
+	for _, de := range des {
+		switch ext := filepath.Ext(de.Name()); ext {
+		case ".yaml", ".yml", ".toml":
+			names = append(names, strings.TrimSuffix(de.Name(), ext))
+		}
+	}
+	return names
+}
+
+// walk calls fn for c and all its sub-commands
+func (c *compCommand) walk(fn func(c *compCommand)) {
+	fn(c)
+	for _, sub := range c.subs {
+		sub.walk(fn)
+	}
+}
+
+// valueFlags returns the flags taking values of c and all its sub-commands (without duplicates by the first name)
+func (c *compCommand) valueFlags() (flags []*compFlag) {
+	seen := map[string]bool{}
+	c.walk(func(c *compCommand) {
+		for _, f := range c.flags {
+			if f.takesValue && !seen[f.names[0]] {
+				seen[f.names[0]] = true
+				flags = append(flags, f)
+			}
+		}
+	})
+	return flags
+}
+
+// subNames returns the names (and aliases) of sub-commands of c
+func (c *compCommand) subNames() (names []string) {
+	for _, sub := range c.subs {
+		names = append(names, sub.names...)
+	}
+	return names
+}
+
+// flagNames returns the names of flags of c
+func (c *compCommand) flagNames() (names []string) {
+	for _, f := range c.flags {
+		names = append(names, f.names...)
+	}
+	return names
+}
+
+// funcName returns the name of shell function of c, e.g. "_vl_sort"
+func (c *compCommand) funcName() string {
+	return "_" + strings.NewReplacer(" ", "_", "-", "_").Replace(c.path)
+}
+
+// compDesc returns the first sentence of usage `s` without placeholders, for the descriptions of zsh and fish
+func compDesc(s string) string {
+	s = strings.ReplaceAll(s, "`", "")
+	if i := strings.IndexAny(s, ";\n"); i > 0 {
+		s = s[:i]
+	}
+	if len(s) > 80 {
+		s = paw.Truncate(s, 79, "…")
+	}
+	return s
+}
+
+// shellQuote quotes s by single quotes for shell
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// writePathCases writes the cases of shell `case` updating the command path by the word `w`
+func writePathCases(w io.Writer, root *compCommand, pattern func(path, name string) string, body func(path string) string) {
+	root.walk(func(c *compCommand) {
+		if len(c.subs) == 0 {
+			return
+		}
+		pats := make([]string, 0)
+		for _, sub := range c.subs {
+			for _, name := range sub.names {
+				pats = append(pats, pattern(c.path, name))
+			}
+			fmt.Fprintf(w, "            %s) %s ;;\n", strings.Join(pats, "|"), body(sub.path))
+			pats = pats[:0]
+		}
+	})
+}
+
+func writeBashCompletion(w io.Writer, root *compCommand) {
+	var (
+		name   = root.names[0]
+		vflags = root.valueFlags()
+	)
+	fmt.Fprintf(w, "# bash completion for %s, generated by `%s completion bash`\n\n", name, name)
+	fmt.Fprintf(w, "%s() {\n", root.funcName())
+	fmt.Fprintln(w, `    local cur="${COMP_WORDS[COMP_CWORD]}" prev="${COMP_WORDS[COMP_CWORD-1]}"`)
+	fmt.Fprintf(w, "    local path=%s i word\n", shellQuote(root.path))
+	fmt.Fprintln(w, `    for ((i = 1; i < COMP_CWORD; i++)); do`)
+	fmt.Fprintln(w, `        word="${COMP_WORDS[i]}"`)
+	fmt.Fprintln(w, `        case "$word" in`)
+	fmt.Fprintf(w, "            %s) ((i++)); continue ;;\n", strings.Join(quoteAll(flagNamesOf(vflags)), "|"))
+	fmt.Fprintln(w, `        esac`)
+	fmt.Fprintln(w, `        case "$path $word" in`)
+	writePathCases(w, root,
+		func(path, name string) string { return shellQuote(path + " " + name) },
+		func(path string) string { return "path=" + shellQuote(path) })
+	fmt.Fprintln(w, `        esac`)
+	fmt.Fprintln(w, `    done`)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, `    local values="" comma="" files=""`)
+	fmt.Fprintln(w, `    case "$prev" in`)
+	for _, f := range vflags {
+		fmt.Fprintf(w, "        %s) values=%s comma=%s files=%s ;;\n",
+			strings.Join(quoteAll(f.names), "|"),
+			shellQuote(strings.Join(f.values, " ")), boolWord(f.isComma), boolWord(f.isFile))
+	}
+	fmt.Fprintln(w, `        *) prev="" ;;`)
+	fmt.Fprintln(w, `    esac`)
+	fmt.Fprintln(w, `    if [[ -n "$prev" ]]; then`)
+	fmt.Fprintln(w, `        local pre=""`)
+	fmt.Fprintln(w, `        if [[ -n "$comma" && "$cur" == *,* ]]; then`)
+	fmt.Fprintln(w, `            pre="${cur%,*},"`)
+	fmt.Fprintln(w, `        fi`)
+	fmt.Fprintln(w, `        COMPREPLY=($(compgen -P "$pre" -W "$values" -- "${cur#"$pre"}"))`)
+	fmt.Fprintln(w, `        [[ -n "$files" ]] && COMPREPLY+=($(compgen -f -- "$cur"))`)
+	fmt.Fprintln(w, `        return`)
+	fmt.Fprintln(w, `    fi`)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, `    local flags="" cmds=""`)
+	fmt.Fprintln(w, `    case "$path" in`)
+	root.walk(func(c *compCommand) {
+		fmt.Fprintf(w, "        %s) flags=%s cmds=%s ;;\n",
+			shellQuote(c.path), shellQuote(strings.Join(c.flagNames(), " ")), shellQuote(strings.Join(c.subNames(), " ")))
+	})
+	fmt.Fprintln(w, `    esac`)
+	fmt.Fprintln(w, `    if [[ "$cur" == -* ]]; then`)
+	fmt.Fprintln(w, `        COMPREPLY=($(compgen -W "$flags" -- "$cur"))`)
+	fmt.Fprintln(w, `    else`)
+	fmt.Fprintln(w, `        COMPREPLY=($(compgen -W "$cmds" -- "$cur") $(compgen -f -- "$cur"))`)
+	fmt.Fprintln(w, `    fi`)
+	fmt.Fprintln(w, `}`)
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "complete -o filenames -o bashdefault -F %s %s\n", root.funcName(), name)
+}
+
+func writeZshCompletion(w io.Writer, root *compCommand) {
+	var (
+		name   = root.names[0]
+		vflags = root.valueFlags()
+	)
+	zdesc := func(name, usage string) string {
+		return shellQuote(strings.ReplaceAll(name, ":", `\:`) + ":" + compDesc(usage))
+	}
+	fmt.Fprintf(w, "#compdef %s\n# zsh completion for %s, generated by `%s completion zsh`\n\n", name, name, name)
+	fmt.Fprintf(w, "%s() {\n", root.funcName())
+	fmt.Fprintf(w, "    local path_=%s word i\n", shellQuote(root.path))
+	fmt.Fprintln(w, `    local cur="${words[CURRENT]}" prev="${words[CURRENT-1]}"`)
+	fmt.Fprintln(w, `    for ((i = 2; i < CURRENT; i++)); do`)
+	fmt.Fprintln(w, `        word="${words[i]}"`)
+	fmt.Fprintln(w, `        case "$word" in`)
+	fmt.Fprintf(w, "            %s) ((i++)); continue ;;\n", strings.Join(quoteAll(flagNamesOf(vflags)), "|"))
+	fmt.Fprintln(w, `        esac`)
+	fmt.Fprintln(w, `        case "$path_ $word" in`)
+	writePathCases(w, root,
+		func(path, name string) string { return shellQuote(path + " " + name) },
+		func(path string) string { return "path_=" + shellQuote(path) })
+	fmt.Fprintln(w, `        esac`)
+	fmt.Fprintln(w, `    done`)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, `    case "$prev" in`)
+	for _, f := range vflags {
+		body := ""
+		if f.isComma {
+			body += "compset -P '*,'; "
+		}
+		if len(f.values) > 0 {
+			body += "compadd -- " + strings.Join(quoteAll(f.values), " ") + "; "
+		}
+		if f.isFile {
+			body += "_files; "
+		}
+		fmt.Fprintf(w, "        %s) %sreturn ;;\n", strings.Join(quoteAll(f.names), "|"), body)
+	}
+	fmt.Fprintln(w, `    esac`)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, `    local -a flags cmds`)
+	fmt.Fprintln(w, `    case "$path_" in`)
+	root.walk(func(c *compCommand) {
+		fmt.Fprintf(w, "        %s)\n", shellQuote(c.path))
+		fmt.Fprint(w, "            flags=(")
+		for _, f := range c.flags {
+			for _, fname := range f.names {
+				fmt.Fprintf(w, "\n                %s", zdesc(fname, f.usage))
+			}
+		}
+		fmt.Fprintln(w, ")")
+		fmt.Fprint(w, "            cmds=(")
+		for _, sub := range c.subs {
+			for _, sname := range sub.names {
+				fmt.Fprintf(w, "\n                %s", zdesc(sname, sub.usage))
+			}
+		}
+		fmt.Fprintln(w, ")")
+		fmt.Fprintln(w, "            ;;")
+	})
+	fmt.Fprintln(w, `    esac`)
+	fmt.Fprintln(w, `    if [[ "$cur" == -* ]]; then`)
+	fmt.Fprintln(w, `        _describe -t flags 'flag' flags`)
+	fmt.Fprintln(w, `    else`)
+	fmt.Fprintln(w, `        (( ${#cmds} )) && _describe -t commands 'command' cmds`)
+	fmt.Fprintln(w, `        _files`)
+	fmt.Fprintln(w, `    fi`)
+	fmt.Fprintln(w, `}`)
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "if [[ \"$funcstack[1]\" == %s ]]; then\n    %s \"$@\"\nelse\n    compdef %s %s\nfi\n",
+		root.funcName(), root.funcName(), root.funcName(), name)
+}
+
+func writeFishCompletion(w io.Writer, root *compCommand) {
+	var (
+		name   = root.names[0]
+		fpath  = root.funcName() + "_path"
+		vflags = root.valueFlags()
+	)
+	fmt.Fprintf(w, "# fish completion for %s, generated by `%s completion fish`\n\n", name, name)
+	fmt.Fprintf(w, "function %s\n", fpath)
+	fmt.Fprintln(w, `    set -l tokens (commandline -opc)`)
+	fmt.Fprintln(w, `    set -e tokens[1]`)
+	fmt.Fprintf(w, "    set -l path %s\n", shellQuote(root.path))
+	fmt.Fprintln(w, `    set -l skip 0`)
+	fmt.Fprintln(w, `    for word in $tokens`)
+	fmt.Fprintln(w, `        if test $skip = 1`)
+	fmt.Fprintln(w, `            set skip 0`)
+	fmt.Fprintln(w, `            continue`)
+	fmt.Fprintln(w, `        end`)
+	fmt.Fprintln(w, `        switch $word`)
+	fmt.Fprintf(w, "            case %s\n", strings.Join(quoteAll(flagNamesOf(vflags)), " "))
+	fmt.Fprintln(w, `                set skip 1`)
+	fmt.Fprintln(w, `                continue`)
+	fmt.Fprintln(w, `        end`)
+	fmt.Fprintln(w, `        switch "$path $word"`)
+	root.walk(func(c *compCommand) {
+		for _, sub := range c.subs {
+			pats := make([]string, 0, len(sub.names))
+			for _, sname := range sub.names {
+				pats = append(pats, shellQuote(c.path+" "+sname))
+			}
+			fmt.Fprintf(w, "            case %s\n                set path %s\n", strings.Join(pats, " "), shellQuote(sub.path))
+		}
+	})
+	fmt.Fprintln(w, `        end`)
+	fmt.Fprintln(w, `    end`)
+	fmt.Fprintln(w, `    echo $path`)
+	fmt.Fprintln(w, `end`)
+	fmt.Fprintln(w)
+
+	root.walk(func(c *compCommand) {
+		cond := shellQuote(fmt.Sprintf("test (%s) = %s", fpath, shellQuote(c.path)))
+		for _, sub := range c.subs {
+			fmt.Fprintf(w, "complete -c %s -n %s -a %s -d %s\n",
+				name, cond, shellQuote(strings.Join(sub.names, " ")), shellQuote(compDesc(sub.usage)))
+		}
+		for _, f := range c.flags {
+			opts := ""
+			for _, fname := range f.names {
+				if strings.HasPrefix(fname, "--") {
+					opts += " -l " + shellQuote(fname[2:])
+				} else {
+					opts += " -s " + shellQuote(fname[1:])
+				}
+			}
+			if f.takesValue {
+				opts += " -r"
+				if !f.isFile {
+					opts += " -f"
+				}
+				if len(f.values) > 0 {
+					opts += " -a " + shellQuote(strings.Join(f.values, " "))
+				}
+			}
+			fmt.Fprintf(w, "complete -c %s -n %s%s -d %s\n", name, cond, opts, shellQuote(compDesc(f.usage)))
+		}
+	})
+}
+
+// flagNamesOf returns all names of flags
+func flagNamesOf(flags []*compFlag) (names []string) {
+	for _, f := range flags {
+		names = append(names, f.names...)
+	}
+	return names
+}
+
+// quoteAll quotes every string of ss by single quotes for shell
+func quoteAll(ss []string) []string {
+	qs := make([]string, len(ss))
+	for i, s := range ss {
+		qs[i] = shellQuote(s)
+	}
+	return qs
+}
+
+// boolWord returns "1" if b is true, or "" for shell
+func boolWord(b bool) string {
+	if b {
+		return "1"
+	}
+	return "''"
+}
@@ -0,0 +1,283 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli"
+)
+
+var (
+	// -------------------------------------------
+	// Gen
+	cmd_Gen = &cli.Command{
+		Name:  "gen",
+		Usage: "generate the documents of commands and flags",
+		Subcommands: []*cli.Command{
+			{
+				Name:   "man",
+				Usage:  "print the man page (roff), e.g. `vl gen man > vl.1` or `vl gen man | man -l -`",
+				Action: genManAction,
+			},
+			{
+				Name:   "docs",
+				Usage:  "print the reference in Markdown, e.g. `vl gen docs > vl.md`",
+				Action: genDocsAction,
+			},
+		},
+	}
+)
+
+// docEnvs are the environment variables used by vl, for the documents
+var docEnvs = [][2]string{
+	{"LS_COLORS", "colors of files (see dircolors(1)), replaced by --theme"},
+	{"EXA_COLORS", "colors of files and fields (like as exa(1)), overrides LS_COLORS and --theme"},
+	{"NO_COLOR, CLICOLOR, CLICOLOR_FORCE", "the policy of colors in --color=auto"},
+	{"COLORTERM, TERM", "the depth of colors of terminal"},
+	{"PAGER, LESS, LV", "the pager of --paging, use the built-in pager if PAGER is unset and less is not found; PAGER=\"\" or \"cat\" disables paging"},
+	{"QUOTING_STYLE", "the default of --quoting-style"},
+	{"FORCE_HYPERLINK", "emit hyperlinks in --hyperlink=auto if it is not \"0\", or never if it is \"0\""},
+	{"LC_ALL, LC_COLLATE, LANG", "the locale of --collate=auto"},
+	{"XDG_CONFIG_HOME", "the directory of configurations, default is ~/.config"},
+}
+
+// docFiles are the files used by vl, for the documents
+var docFiles = [][2]string{
+	{"~/.config/vl/config.yaml", "the user configurations, the defaults of flags"},
+	{"~/.config/vl/themes/", "the user themes (.yaml, .yml or .toml) used by name in --theme"},
+}
+
+// docFlag is a flag of the cli definitions for the documents
+type docFlag struct {
+	names       []string // e.g. "--sortby", "-f"
+	placeholder string
+	usage       string
+	value       string // default
+}
+
+func newDocFlag(f cli.Flag) *docFlag {
+	df := &docFlag{}
+	for _, name := range f.Names() {
+		if len(name) == 1 {
+			df.names = append(df.names, "-"+name)
+		} else {
+			df.names = append(df.names, "--"+name)
+		}
+	}
+	if gf, ok := f.(interface{ GetUsage() string }); ok {
+		df.placeholder, df.usage = unquoteUsage(gf.GetUsage())
+	}
+	if tf, ok := f.(interface{ TakesValue() bool }); ok && tf.TakesValue() {
+		if len(df.placeholder) == 0 {
+			df.placeholder = "value"
+		}
+		if vf, ok := f.(interface{ GetValue() string }); ok {
+			df.value = vf.GetValue()
+		}
+	} else {
+		df.placeholder = ""
+	}
+	return df
+}
+
+// unquoteUsage returns the placeholder quoted by the first pair of back quotes in `usage` (like as the help of cli), and the usage without back quotes
+func unquoteUsage(usage string) (placeholder, unquoted string) {
+	if i := strings.IndexByte(usage, '`'); i >= 0 {
+		if j := strings.IndexByte(usage[i+1:], '`'); j >= 0 {
+			placeholder = usage[i+1 : i+1+j]
+		}
+	}
+	return placeholder, strings.ReplaceAll(usage, "`", "")
+}
+
+// visibleCommands returns the commands, excluding hidden ones
+func visibleCommands(cmds []*cli.Command) (visibles []*cli.Command) {
+	for _, cmd := range cmds {
+		if !cmd.Hidden {
+			visibles = append(visibles, cmd)
+		}
+	}
+	return visibles
+}
+
+// genManAction and genDocsAction document the root app, because c.App is the sub-app of `gen` built by cli
+var genManAction cli.ActionFunc = func(c *cli.Context) error {
+	lg.Debug()
+	writeMan(os.Stdout, app)
+	return nil
+}
+
+var genDocsAction cli.ActionFunc = func(c *cli.Context) error {
+	lg.Debug()
+	writeDocs(os.Stdout, app)
+	return nil
+}
+
+// roffEscaper escapes the special characters of roff
+var roffEscaper = strings.NewReplacer(`\`, `\e`, "-", `\-`, "'", `\(aq`)
+
+// roff returns `s` escaped for roff, with the control characters at the beginning of lines protected
+func roff(s string) string {
+	s = roffEscaper.Replace(s)
+	if strings.HasPrefix(s, ".") {
+		s = `\&` + s
+	}
+	return strings.ReplaceAll(s, "\n.", "\n\\&.")
+}
+
+func writeMan(w io.Writer, app *cli.App) {
+	name := app.Name
+	fmt.Fprintf(w, ".TH \"%s\" 1 \"%s\" \"%s\" \"%s\"\n",
+		strings.ToUpper(name), app.Compiled.Format("January 2006"), name+" "+app.Version, "User Commands")
+	fmt.Fprintf(w, ".SH NAME\n%s \\- %s\n", roff(name), roff(app.Usage))
+	fmt.Fprintf(w, ".SH SYNOPSIS\n.B %s\n[\\fIcommand\\fR] [\\fIcommand options\\fR] [\\fIpath\\fR]\n", roff(name))
+	if len(app.Description) > 0 {
+		fmt.Fprintf(w, ".SH DESCRIPTION\n%s\n", roff(app.Description))
+	}
+	fmt.Fprintln(w, ".SH OPTIONS")
+	writeManFlags(w, app.Flags)
+
+	fmt.Fprintln(w, ".SH COMMANDS")
+	var walk func(prefix string, cmds []*cli.Command)
+	walk = func(prefix string, cmds []*cli.Command) {
+		for _, cmd := range visibleCommands(cmds) {
+			fmt.Fprintf(w, ".SS \"%s\"\n", roff(prefix+strings.Join(cmd.Names(), ", ")))
+			synopsis := fmt.Sprintf("%s %s%s", name, prefix, cmd.Name)
+			if len(cmd.Flags) > 0 {
+				synopsis += " [command options]"
+			}
+			if len(cmd.ArgsUsage) > 0 {
+				synopsis += " " + cmd.ArgsUsage
+			}
+			fmt.Fprintf(w, ".B %s\n.PP\n%s\n", roff(synopsis), roff(strings.ReplaceAll(cmd.Usage, "`", "")))
+			if len(cmd.Flags) > 0 {
+				writeManFlags(w, cmd.Flags)
+			}
+			walk(prefix+cmd.Name+" ", cmd.Subcommands)
+		}
+	}
+	walk("", app.Commands)
+
+	fmt.Fprintln(w, ".SH ENVIRONMENT")
+	for _, env := range docEnvs {
+		fmt.Fprintf(w, ".TP\n.B %s\n%s\n", roff(env[0]), roff(env[1]))
+	}
+	fmt.Fprintln(w, ".SH FILES")
+	for _, file := range docFiles {
+		fmt.Fprintf(w, ".TP\n.I %s\n%s\n", roff(file[0]), roff(file[1]))
+	}
+	if len(app.Authors) > 0 {
+		fmt.Fprintln(w, ".SH AUTHOR")
+		for _, a := range app.Authors {
+			fmt.Fprintf(w, "%s <%s>\n.br\n", roff(a.Name), roff(a.Email))
+		}
+	}
+}
+
+func writeManFlags(w io.Writer, flags []cli.Flag) {
+	for _, f := range flags {
+		df := newDocFlag(f)
+		names := make([]string, 0, len(df.names))
+		for _, name := range df.names {
+			names = append(names, `\fB`+roff(name)+`\fR`)
+		}
+		fmt.Fprintf(w, ".TP\n%s", strings.Join(names, ", "))
+		if len(df.placeholder) > 0 {
+			fmt.Fprintf(w, " \\fI%s\\fR", roff(df.placeholder))
+		}
+		fmt.Fprintf(w, "\n%s", roff(df.usage))
+		if len(df.value) > 0 {
+			fmt.Fprintf(w, " (default: %s)", roff(df.value))
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+func writeDocs(w io.Writer, app *cli.App) {
+	name := app.Name
+	fmt.Fprintf(w, "# %s\n\n%s\n\n", name, app.Usage)
+	fmt.Fprintf(w, "Version %s (%s)\n\n", app.Version, app.Compiled.Format("Jan 2, 2006"))
+	fmt.Fprintf(w, "## Synopsis\n\n```\n%s [command] [command options] [path]\n```\n\n", name)
+	if len(app.Description) > 0 {
+		fmt.Fprintf(w, "## Description\n\n%s\n\n", app.Description)
+	}
+
+	fmt.Fprintln(w, "## Commands")
+	fmt.Fprintln(w)
+	var toc func(depth int, prefix string, cmds []*cli.Command)
+	toc = func(depth int, prefix string, cmds []*cli.Command) {
+		for _, cmd := range visibleCommands(cmds) {
+			fmt.Fprintf(w, "%s- [`%s%s`](#%s): %s\n", strings.Repeat("  ", depth), prefix, cmd.Name,
+				mdAnchor(name+" "+prefix+cmd.Name), cmd.Usage)
+			toc(depth+1, prefix+cmd.Name+" ", cmd.Subcommands)
+		}
+	}
+	toc(0, "", app.Commands)
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, "## Options")
+	fmt.Fprintln(w)
+	writeDocsFlags(w, app.Flags)
+
+	var walk func(prefix string, cmds []*cli.Command)
+	walk = func(prefix string, cmds []*cli.Command) {
+		for _, cmd := range visibleCommands(cmds) {
+			fmt.Fprintf(w, "## %s %s%s\n\n", name, prefix, cmd.Name)
+			if len(cmd.Aliases) > 0 {
+				fmt.Fprintf(w, "Aliases: `%s`\n\n", strings.Join(cmd.Aliases, "`, `"))
+			}
+			fmt.Fprintf(w, "%s\n\n", cmd.Usage)
+			synopsis := fmt.Sprintf("%s %s%s", name, prefix, cmd.Name)
+			if len(cmd.Flags) > 0 {
+				synopsis += " [command options]"
+			}
+			if len(cmd.ArgsUsage) > 0 {
+				synopsis += " " + cmd.ArgsUsage
+			}
+			fmt.Fprintf(w, "```\n%s\n```\n\n", synopsis)
+			if len(cmd.Flags) > 0 {
+				writeDocsFlags(w, cmd.Flags)
+			}
+			walk(prefix+cmd.Name+" ", cmd.Subcommands)
+		}
+	}
+	walk("", app.Commands)
+
+	fmt.Fprintln(w, "## Environment")
+	fmt.Fprintln(w)
+	for _, env := range docEnvs {
+		fmt.Fprintf(w, "- `%s`: %s\n", strings.Join(strings.Split(env[0], ", "), "`, `"), env[1])
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "## Files")
+	fmt.Fprintln(w)
+	for _, file := range docFiles {
+		fmt.Fprintf(w, "- `%s`: %s\n", file[0], file[1])
+	}
+}
+
+func writeDocsFlags(w io.Writer, flags []cli.Flag) {
+	for _, f := range flags {
+		df := newDocFlag(f)
+		fmt.Fprintf(w, "- `%s`", strings.Join(df.names, "`, `"))
+		if len(df.placeholder) > 0 {
+			fmt.Fprintf(w, " *%s*", df.placeholder)
+		}
+		fmt.Fprintf(w, ": %s", mdEscaper.Replace(df.usage))
+		if len(df.value) > 0 {
+			fmt.Fprintf(w, " (default: `%s`)", df.value)
+		}
+		fmt.Fprintln(w)
+	}
+	fmt.Fprintln(w)
+}
+
+// mdEscaper escapes the characters of Markdown in the usages of flags
+var mdEscaper = strings.NewReplacer("*", `\*`, "_", `\_`, "<", `\<`, "|", `\|`)
+
+// mdAnchor returns the anchor of heading `s` in GitHub-flavored Markdown
+func mdAnchor(s string) string {
+	return strings.ReplaceAll(strings.ToLower(s), " ", "-")
+}
@@ -73,7 +73,10 @@ func init() {
 	// read config of app from embeded `assets/config.yaml`
 	readConfig()
 
-	paw.GologInit(os.Stdout, os.Stderr, os.Stderr, false)
+	setLogOutputs(paw.ColorAuto)
+
+	// read config of user, e.g. icons, from `~/.config/vl/config.yaml`
+	readUserConfig()
 
 	cli.VersionFlag = &cli.BoolFlag{
 		Name:    "version",
@@ -112,7 +115,7 @@ func init() {
 			},
 		},
 		UseShortOptionHandling: true,
-		// EnableBashCompletion : true,
+		EnableBashCompletion:   true,
 		Commands: []*cli.Command{
 			cmd_Version,
 			//  ViewType
@@ -123,26 +126,37 @@ func init() {
 			cmd_SkipConds,
 			// ViewFields
 			cmd_ViewField,
+			// Compare
+			cmd_Compare,
+			// Completion
+			cmd_Completion,
+			// Gen
+			cmd_Gen,
 		},
 
 		Flags: []cli.Flag{
 			// verbose
-			fg_isInfo, fg_isDebug, fg_isTrace, fg_isDump,
+			fg_isInfo, fg_isDebug, fg_isTrace, fg_isDump, fg_isJSON,
 			//  ViewType
 			fg_isViewList, fg_isViewLevel, fg_isViewListTree, fg_isViewTree, fg_isViewTable, fg_isViewClassify,
-			fg_isViewX, fg_isViewGroup, fg_isViewGroupR,
+			fg_isViewGrid, fg_isGridAcross, fg_gridField,
+			fg_viewName, fg_outputFormat, fg_isViewX, fg_isXattrValue, fg_isViewGroup, fg_isViewGroupR, fg_groupBy, fg_isMerged,
 			fg_isViewNoDirs, fg_isViewNoFiles,
 			// Depth
 			fg_Depth, fg_IsFindRecurse, fg_isForceRecurse,
+			// Symlink
+			fg_followMode, fg_isDereference,
 			// ByField (sort)
 			fg_isSortNo, fg_isSortReverse, fg_sortByField, fg_isSortByName,
 			fg_isSortByINode, fg_isSortBySize, fg_isSortByHDLinks, fg_isSortByBlocks,
 			fg_isSortByUser, fg_isSortByGroup,
 			fg_isSortByMTime, fg_isSortByATime, fg_isSortByCTime,
-			fg_isSortByMd5,
+			fg_isSortByMd5, fg_isSortByMime, fg_isSortByLines,
+			fg_isSortByExt, fg_isSortByGit, fg_isSortByVersion, fg_collate,
 			// SkipConds
 			fg_isNoSkip, fg_reIncludePattern, fg_reExcludePattern,
 			fg_withNoPrefix, fg_withNoSufix, fg_psDelimiter,
+			fg_hasXattrs, fg_hasMimeTypes,
 			// ViewFields
 			fg_hasAll, fg_hasAllNoGit, fg_hasAllNoMd5, fg_hasAllNoGitMd5,
 			fg_hasBasicPSUGMN,
@@ -152,6 +166,18 @@ func init() {
 			fg_hasUser, fg_hasGroup,
 			fg_hasMTime, fg_hasATime, fg_hasCTime,
 			fg_hasGit, fg_hasMd5,
+			fg_hasACL, fg_hasCapability, fg_hasContext,
+			fg_hasMimeType, fg_hasFileType,
+			fg_hasLines, fg_hasWords, fg_hasEncoding,
+			fg_hasIcons,
+			// Color
+			fg_color, fg_hyperlink,
+			// Theme
+			fg_theme, fg_dircolors,
+			// Pager
+			fg_paging, fg_isStream,
+			// Quoting
+			fg_quotingStyle, fg_isNull,
 		},
 		Action: appAction,
 	}
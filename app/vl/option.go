@@ -1,6 +1,7 @@
 package main
 
 import (
+	"github.com/shyang107/paw"
 	"github.com/shyang107/paw/vfs"
 	"github.com/urfave/cli"
 )
@@ -10,6 +11,7 @@ type option struct {
 	isDebug bool
 	isInfo  bool
 	isDump  bool
+	isJSON  bool
 	// VFS
 	rootPath string
 	paths    []string
@@ -25,13 +27,22 @@ type option struct {
 	// ViewType
 	viewType       vfs.ViewType
 	grouping       vfs.Group
+	groupBy        string
+	isMerged       bool
 	isViewList     bool
 	isViewLevel    bool
 	isViewListTree bool
 	isViewTree     bool
 	isViewTable    bool
 	isViewClassify bool
+	isViewGrid     bool
+	isGridAcross   bool
+	gridField      string
+	gridViewField  vfs.ViewField
+	viewName       string
+	outputFormat   string
 	isViewX        bool
+	isXattrValue   bool
 	isViewGroup    bool
 	isViewGroupR   bool
 	isViewNoFiles  bool
@@ -40,6 +51,10 @@ type option struct {
 	depth          int
 	IsFindRecurse  bool
 	isForceRecurse bool
+	// Symlink
+	follow        vfs.FollowMode
+	followMode    string
+	isDereference bool
 	// ByField (sort)
 	byField         vfs.SortKey
 	isSortNo        bool
@@ -56,6 +71,18 @@ type option struct {
 	isSortByATime   bool
 	isSortByCTime   bool
 	isSortByMd5     bool
+	isSortByMime    bool
+	isSortByLines   bool
+	isSortByExt     bool
+	isSortByGit     bool
+	isSortByVersion bool
+	sortKeys        vfs.SortKeys
+	collate         string
+	// Compare
+	isHideIdentical   bool
+	isCompareChecksum bool
+	isIgnoreMTime     bool
+	isIgnoreMode      bool
 	// SkipConds
 	skips            *vfs.SkipConds
 	isNoSkip         bool
@@ -64,6 +91,8 @@ type option struct {
 	psDelimiter      string
 	withNoPrefix     string
 	withNoSufix      string
+	hasXattrs        string
+	hasMimeTypes     string
 	// ViewFields
 	viewFields     vfs.ViewField
 	hasAll         bool
@@ -83,6 +112,32 @@ type option struct {
 	hasCTime       bool
 	hasGit         bool
 	hasMd5         bool
+	hasACL         bool
+	hasCapability  bool
+	hasContext     bool
+	hasMimeType    bool
+	hasFileType    bool
+	hasLines       bool
+	hasWords       bool
+	hasEncoding    bool
+	hasIcons       bool
+	// Color
+	color         string
+	colorMode     paw.ColorMode
+	hyperlink     string
+	hyperlinkMode paw.HyperlinkMode
+	// Theme
+	theme     string
+	dircolors string
+	// Pager
+	paging     string
+	pagingMode paw.PagingMode
+	isStream   bool
+	out        *paw.Pager
+	// Quoting
+	quotingStyle string
+	quoting      vfs.QuotingStyle
+	isNull       bool
 }
 
 var (
@@ -118,4 +173,11 @@ var (
 		Usage:       "directly dump files",
 		Destination: &opt.isDump,
 	}
+	fg_isJSON = &cli.BoolFlag{
+		Name:        "json",
+		Aliases:     []string{},
+		Value:       false,
+		Usage:       "print the snapshot of files as JSON, including extended attributes with values",
+		Destination: &opt.isJSON,
+	}
 )
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"os"
+
+	"github.com/shyang107/paw"
+	"github.com/urfave/cli"
+)
+
+var (
+	// -------------------------------------------
+	// Pager
+	fg_paging = &cli.StringFlag{
+		Name:        "paging",
+		Aliases:     []string{"pg"},
+		Value:       "auto",
+		Usage:       "page the output `when`: auto (only to terminal, and only if it exceeds the height of terminal), always or never; use $PAGER, or less, or the built-in pager",
+		Destination: &opt.paging,
+	}
+	fg_isStream = &cli.BoolFlag{
+		Name:        "stream",
+		Aliases:     []string{"sm"},
+		Value:       false,
+		Usage:       "print each directory as soon as it is scanned, instead of after scanning all (list and level views)",
+		Destination: &opt.isStream,
+	}
+)
+
+func (opt *option) checkPaging() {
+	lg.Debug(paw.Caller(1))
+
+	mode, err := paw.ParsePagingMode(opt.paging)
+	if err != nil {
+		warningf("%v, use \"auto\"\n", err)
+	}
+	opt.pagingMode = mode
+	// the null-separated paths are for pipes
+	if opt.isNull {
+		opt.pagingMode = paw.PagingNever
+	}
+	opt.out = paw.NewPager(os.Stdout, opt.pagingMode)
+
+	info(paw.ValuePairA([]*paw.ValuePair{
+		paw.NewValuePair("Paging", opt.pagingMode),
+		paw.NewValuePair("Stream", opt.isStream),
+	}))
+}
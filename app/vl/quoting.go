@@ -0,0 +1,85 @@
+package main
+
+import (
+	"os"
+
+	"github.com/shyang107/paw"
+	"github.com/shyang107/paw/vfs"
+	"github.com/urfave/cli"
+)
+
+var (
+	// -------------------------------------------
+	// Quoting
+	fg_quotingStyle = &cli.StringFlag{
+		Name:        "quoting-style",
+		Aliases:     []string{"qs"},
+		Value:       "",
+		Usage:       "quote names in the style `word`: literal, shell, shell-escape, c or escape; default is $QUOTING_STYLE, or shell-escape to terminal and literal otherwise",
+		Destination: &opt.quotingStyle,
+	}
+	fg_isNull = &cli.BoolFlag{
+		Name:        "null",
+		Aliases:     []string{"0"},
+		Value:       false,
+		Usage:       "print the unquoted paths separated by NUL instead of views, e.g. for xargs -0",
+		Destination: &opt.isNull,
+	}
+)
+
+func (opt *option) checkQuoting() {
+	lg.Debug(paw.Caller(1))
+
+	name := opt.quotingStyle
+	if len(name) == 0 {
+		name = os.Getenv("QUOTING_STYLE")
+	}
+	if len(name) == 0 {
+		opt.quoting = vfs.QuotingLiteral
+		if paw.IsTerminal(os.Stdout) {
+			opt.quoting = vfs.QuotingShellEscape
+		}
+	} else {
+		q, err := vfs.ParseQuotingStyle(name)
+		if err != nil {
+			warningf("%v, use \"literal\"\n", err)
+		}
+		opt.quoting = q
+	}
+
+	info(paw.ValuePairA([]*paw.ValuePair{
+		paw.NewValuePair("Quoting", opt.quoting),
+		paw.NewValuePair("Null", opt.isNull),
+	}))
+}
+
+// viewNull prints the paths of entries in the root, or of opt.paths (the entries in directories), separated by NUL (see vfs.VFS.FprintPaths)
+func (opt *option) viewNull() error {
+	lg.Debug()
+
+	paths := opt.paths
+	if len(paths) < 1 {
+		paths = []string{opt.rootPath}
+	}
+	for _, path := range paths {
+		fi, err := os.Stat(path)
+		if err != nil {
+			warning(err)
+			continue
+		}
+		if !fi.IsDir() {
+			opt.out.Write([]byte(path + "\x00"))
+			continue
+		}
+		vopt := *opt.vopt
+		fs, err := vfs.NewVFS(path, &vopt)
+		if err != nil {
+			return err
+		}
+		if err := fs.BuildFS(); err != nil {
+			fatal(err)
+		}
+		fs.FprintPaths(opt.out, 0)
+	}
+	return nil
+}
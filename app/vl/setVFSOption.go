@@ -10,20 +10,38 @@ func (opt *option) setVFSOption() {
 	opt.vopt = &vfs.VFSOption{
 		Depth:          opt.depth,
 		IsForceRecurse: opt.isForceRecurse,
+		Follow:         opt.follow,
 		Grouping:       opt.grouping,
 		ByField:        opt.byField,
+		SortKeys:       opt.sortKeys,
 		Skips:          opt.skips,
 		ViewFields:     opt.viewFields,
 		ViewType:       opt.viewType,
+		IsXattrValue:   opt.isXattrValue,
+		GridField:      opt.gridViewField,
+		IsGridAcross:   opt.isGridAcross,
+		ViewName:       opt.viewName,
+		IsIcon:         opt.hasIcons,
+		ColorMode:      opt.colorMode,
+		Quoting:        opt.quoting,
 	}
 	info("settings: {",
 		paw.ValuePairA([]*paw.ValuePair{
 			paw.NewValuePair("Depth", opt.vopt.Depth),
 			paw.NewValuePair("IsForceRecurse", opt.vopt.IsForceRecurse),
+			paw.NewValuePair("Follow", opt.vopt.Follow),
 			paw.NewValuePair("Grouping", opt.vopt.Grouping),
 			paw.NewValuePair("ByField", opt.vopt.ByField),
+			paw.NewValuePair("SortKeys", opt.vopt.SortKeys),
 			paw.NewValuePair("Skips", opt.vopt.Skips),
 			paw.NewValuePair("ViewFields", opt.vopt.ViewFields),
 			paw.NewValuePair("ViewType", opt.vopt.ViewType),
+			paw.NewValuePair("IsXattrValue", opt.vopt.IsXattrValue),
+			paw.NewValuePair("GridField", opt.vopt.GridField),
+			paw.NewValuePair("IsGridAcross", opt.vopt.IsGridAcross),
+			paw.NewValuePair("ViewName", opt.vopt.ViewName),
+			paw.NewValuePair("IsIcon", opt.vopt.IsIcon),
+			paw.NewValuePair("ColorMode", opt.vopt.ColorMode),
+			paw.NewValuePair("Quoting", opt.vopt.Quoting),
 		}), "}")
 }
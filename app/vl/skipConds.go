@@ -55,6 +55,20 @@ var (
 		Usage:       "set `delimiter` needed int mutli-[prefixs|suffixs]",
 		Destination: &opt.psDelimiter,
 	}
+	fg_hasXattrs = &cli.StringFlag{
+		Name:        "has-xattr",
+		Aliases:     []string{"hx"},
+		Value:       "",
+		Usage:       "only show files (not dirs) having extended attribute `name` (glob pattern, e.g. user.*); mutli-names: name1,name2,...",
+		Destination: &opt.hasXattrs,
+	}
+	fg_hasMimeTypes = &cli.StringFlag{
+		Name:        "only-mime",
+		Aliases:     []string{"om"},
+		Value:       "",
+		Usage:       "only show files (not dirs) whose MIME type detected by content matches `type` (glob pattern, e.g. image/*); mutli-types: type1,type2,...",
+		Destination: &opt.hasMimeTypes,
+	}
 
 	cmd_SkipConds = &cli.Command{
 		Name:    "skip",
@@ -64,6 +78,7 @@ var (
 			// SkipConds
 			fg_isNoSkip, fg_reIncludePattern, fg_reExcludePattern,
 			fg_withNoPrefix, fg_withNoSufix, fg_psDelimiter,
+			fg_hasXattrs, fg_hasMimeTypes,
 		},
 		Subcommands: []*cli.Command{
 			{
@@ -182,6 +197,24 @@ func (opt *option) checkSkips() {
 		}).Trace()
 	}
 
+	// extended attributes
+	if len(opt.hasXattrs) > 0 {
+		names := strings.Split(opt.hasXattrs, opt.psDelimiter)
+		opt.skips.Add(vfs.NewSkipperNoXattrs(names...))
+		lg.WithFields(logrus.Fields{
+			"xattrs": names,
+		}).Trace()
+	}
+
+	// MIME types
+	if len(opt.hasMimeTypes) > 0 {
+		types := strings.Split(opt.hasMimeTypes, opt.psDelimiter)
+		opt.skips.Add(vfs.NewSkipperNoMimeTypes(types...))
+		lg.WithFields(logrus.Fields{
+			"mime": types,
+		}).Trace()
+	}
+
 	info(paw.NewValuePair("Skiper", opt.skips))
 	// paw.Logger.WithField("skips", opt.skips).Info()
 	// info(paw.MesageFieldAndValueC("Skiper", opt.skips, logrus.InfoLevel, paw.Cnop, nil))
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/shyang107/paw"
+	"github.com/urfave/cli"
+)
+
+var (
+	// -------------------------------------------
+	// Theme
+	fg_theme = &cli.StringFlag{
+		Name:        "theme",
+		Aliases:     []string{"th"},
+		Value:       "",
+		Usage:       "use colors of theme `name` (" + strings.Join(paw.ThemeNames(), ", ") + "), or the path of theme file (.yaml, .yml or .toml); the files in `~/.config/vl/themes` can be used by name. The theme replaces the colors of LS_COLORS but not those of --dircolors, and EXA_COLORS overrides the theme",
+		Destination: &opt.theme,
+	}
+	fg_dircolors = &cli.StringFlag{
+		Name:        "dircolors",
+		Aliases:     []string{"dc"},
+		Value:       "",
+		Usage:       "use the colors of file names from dircolors database `file` (see `dircolors --print-database`) instead of LS_COLORS",
+		Destination: &opt.dircolors,
+	}
+)
+
+// findTheme returns the theme `name`: a built-in theme, a theme file, or a file in the directory of themes of user (e.g. `~/.config/vl/themes/name.yaml`)
+func findTheme(name string) (*paw.Theme, error) {
+	if t, ok := paw.Themes[strings.ToLower(name)]; ok {
+		return t, nil
+	}
+	if _, err := os.Stat(name); err == nil {
+		return paw.LoadTheme(name)
+	}
+	if path := userConfigPath(); len(path) > 0 {
+		dir := filepath.Join(filepath.Dir(path), "themes")
+		for _, ext := range []string{".yaml", ".yml", ".toml"} {
+			file := filepath.Join(dir, name+ext)
+			if _, err := os.Stat(file); err == nil {
+				return paw.LoadTheme(file)
+			}
+		}
+	}
+	return nil, os.ErrNotExist
+}
+
+func (opt *option) checkTheme() {
+	lg.Debug(paw.Caller(1))
+
+	// colors of file names from LS_COLORS, replaced by the theme
+	if len(opt.dircolors) == 0 {
+		paw.GetLSColors()
+	}
+
+	name := opt.theme
+	if len(name) == 0 && cu != nil {
+		name = cu.Theme
+	}
+	if len(name) > 0 {
+		t, err := findTheme(name)
+		if err != nil {
+			warningf("theme %q: %v, use one of %s or a theme file\n", name, err, strings.Join(paw.ThemeNames(), ", "))
+		} else if err := paw.ApplyTheme(t); err != nil {
+			warningf("%v\n", err)
+		} else {
+			name = t.Name
+		}
+	}
+
+	// colors of file names from dircolors database, which is given explicitly, override the theme
+	if len(opt.dircolors) > 0 {
+		if err := paw.LoadDircolors(opt.dircolors); err != nil {
+			warningf("%v\n", err)
+		}
+	}
+
+	// EXA_COLORS overrides the theme
+	paw.GetEXAColors()
+
+	info(paw.ValuePairA([]*paw.ValuePair{
+		paw.NewValuePair("Theme", name),
+		paw.NewValuePair("Dircolors", opt.dircolors),
+	}))
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shyang107/paw"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckTheme(t *testing.T) {
+	assert := assert.New(t)
+	defer paw.ApplyTheme(nil)
+
+	light, err := paw.ParseColorSpec(paw.Themes["light"].Fields["da"])
+	assert.NoError(err)
+
+	tests := []struct {
+		theme     string
+		exaColors string
+		want      []paw.Attribute
+	}{
+		{"light", "", light},
+		{"light", "da=1;33", []paw.Attribute{1, 33}}, // EXA_COLORS overrides the theme
+	}
+	for _, tt := range tests {
+		t.Setenv("EXA_COLORS", tt.exaColors)
+		opt.theme = tt.theme
+		opt.checkTheme()
+		assert.Equal(tt.want, paw.EXAColorAttributes["da"], "theme %q, EXA_COLORS %q", tt.theme, tt.exaColors)
+	}
+	opt.theme = ""
+}
+
+func TestCheckThemeDircolors(t *testing.T) {
+	assert := assert.New(t)
+	defer paw.ApplyTheme(nil)
+
+	path := filepath.Join(t.TempDir(), "dircolors")
+	if err := os.WriteFile(path, []byte("DIR 01;35\n*.go 01;32\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	light, err := paw.ParseColorSpec(paw.Themes["light"].Fields["da"])
+	assert.NoError(err)
+
+	t.Setenv("EXA_COLORS", "")
+	opt.theme, opt.dircolors = "light", path
+	opt.checkTheme()
+	opt.theme, opt.dircolors = "", ""
+
+	// the theme colors the fields, and dircolors database colors the file names
+	assert.Equal(light, paw.EXAColorAttributes["da"])
+	assert.Equal([]paw.Attribute{1, 35}, paw.LSColorAttributes["di"])
+	att, ok := paw.NameLSColorAttributes("main.go")
+	assert.True(ok)
+	assert.Equal([]paw.Attribute{1, 32}, att)
+}
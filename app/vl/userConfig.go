@@ -0,0 +1,89 @@
+package main
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/shyang107/paw"
+	"gopkg.in/yaml.v3"
+)
+
+// userConfig is the optional config of user read from `$XDG_CONFIG_HOME/vl/config.yaml` (default `~/.config/vl/config.yaml`), e.g. (YAML supports the escapes of unicode in double-quoted strings)
+//
+//	icons:
+//	  extensions:
+//	    ".go": "\ue626"
+//	  names:
+//	    "Justfile": "\uf489"
+//	  dirs:
+//	    "src": "\uf121"
+//	theme: solarized
+type userConfig struct {
+	// Theme is the default theme, a built-in name or the path of theme file (see --theme)
+	Theme string `yaml:"theme"`
+	// Collate is the default locale of collation of names (see --collate)
+	Collate string `yaml:"collate"`
+	Icons   struct {
+		// Extensions overrides the icons of file extensions, the leading dot is optional
+		Extensions map[string]string `yaml:"extensions"`
+		// Names overrides the icons of well-known file names
+		Names map[string]string `yaml:"names"`
+		// Dirs overrides the icons of well-known directory names
+		Dirs map[string]string `yaml:"dirs"`
+	} `yaml:"icons"`
+}
+
+var cu *userConfig
+
+// userConfigPath returns the path of config of user, or "" if the home directory is unknown
+func userConfigPath() string {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if len(dir) == 0 {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "vl", "config.yaml")
+}
+
+// readUserConfig reads and applies the config of user if it exists
+func readUserConfig() {
+	cu = new(userConfig)
+	path := userConfigPath()
+	if len(path) == 0 {
+		return
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			warningf("read user config: %v\n", err)
+		}
+		return
+	}
+	if err := yaml.Unmarshal(b, cu); err != nil {
+		warningf("user config %q: %v\n", path, err)
+		return
+	}
+	cu.apply()
+}
+
+func (c *userConfig) apply() {
+	for ext, icon := range c.Icons.Extensions {
+		ext = strings.ToLower(ext)
+		if !strings.HasPrefix(ext, ".") {
+			ext = "." + ext
+		}
+		paw.IconExtensions[ext] = icon
+	}
+	for name, icon := range c.Icons.Names {
+		paw.IconNames[name] = icon
+	}
+	for name, icon := range c.Icons.Dirs {
+		paw.IconDirNames[name] = icon
+	}
+}
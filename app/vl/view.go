@@ -18,29 +18,79 @@ func (opt *option) view() error {
 	lg.WithFields(logrus.Fields{
 		"Depth":          opt.vopt.Depth,
 		"IsForceRecurse": opt.vopt.IsForceRecurse,
+		"Follow":         opt.vopt.Follow,
 		"Grouping":       opt.vopt.Grouping,
 		"ByField":        opt.vopt.ByField,
+		"SortKeys":       opt.vopt.SortKeys,
 		"Skips":          opt.vopt.Skips,
 		"ViewFields":     opt.vopt.ViewFields,
 		"ViewType":       opt.vopt.ViewType,
+		"GridField":      opt.vopt.GridField,
+		"IsGridAcross":   opt.vopt.IsGridAcross,
+		"ViewName":       opt.vopt.ViewName,
+		"ColorMode":      opt.vopt.ColorMode,
 	}).Debug()
 	fs, err := vfs.NewVFS(opt.rootPath, opt.vopt)
 	if err != nil {
 		return err
 	}
 	if opt.isDump {
-		fs.Dump(os.Stdout)
+		fs.Dump(paw.NewColorWriter(opt.out, opt.colorMode))
+	} else if opt.isStream && opt.isStreamable() {
+		fs.Stream(opt.out)
 	} else {
 		err := fs.BuildFS()
 		if err != nil {
 			fatal(err)
 		}
-		fs.View(os.Stdout)
+		if opt.isJSON {
+			return fs.DumpJSON(opt.out)
+		}
+		fs.View(opt.out)
 	}
 
 	return nil
 }
 
+// viewRoots views several root directories, opt.paths, as a merged VFS (see vfs.MultiVFS)
+func (opt *option) viewRoots() error {
+	lg.WithField("roots", opt.paths).Debug()
+
+	mfs, err := vfs.NewMultiVFS(opt.paths, opt.vopt)
+	if err != nil {
+		return err
+	}
+	mfs.IsMerged = opt.isMerged
+	if err := mfs.BuildFS(); err != nil {
+		fatal(err)
+	}
+	mfs.View(opt.out)
+	return nil
+}
+
+// isStreamable returns true if the view can be streamed (see vfs.VFS.Stream), or warns
+func (opt *option) isStreamable() bool {
+	if len(opt.vopt.ViewName) > 0 || !opt.vopt.ViewType.IsStreamable() {
+		warningf("--stream is only for the list and level views, not %v; scan all before viewing\n", opt.vopt.ViewType)
+		return false
+	}
+	return true
+}
+
+// isAllDirs returns true if there are several paths and all of them are directories
+func isAllDirs(paths []string) bool {
+	if len(paths) < 2 {
+		return false
+	}
+	for _, path := range paths {
+		fi, err := os.Stat(path)
+		if err != nil || !fi.IsDir() {
+			return false
+		}
+	}
+	return true
+}
+
 var (
 	sttyHeight, sttyWidth = paw.GetTerminalSize()
 	viewPaths_errors      = []error{}
@@ -50,7 +100,7 @@ func (opt *option) viewPaths() error {
 	lg.Debug()
 
 	var (
-		w       = os.Stdout
+		w       = paw.NewColorWriter(opt.out, opt.colorMode)
 		wdstty  = sttyWidth - 2
 		paths   = opt.paths
 		vfields = opt.viewFields
@@ -62,7 +112,7 @@ func (opt *option) viewPaths() error {
 		c               *color.Color
 	)
 
-	dxs, srm, dirs := createBasepaths(paths)
+	dxs, srm, dirs := createBasepaths(paths, opt.vopt)
 	for i, dir := range dirs {
 		c = paw.ChoseColor(i)
 		de := srm[dir]
@@ -94,7 +144,7 @@ func (opt *option) viewPaths() error {
 		c = paw.ChoseColor(i)
 		rooti := c.Sprintf("{R%d}/", i+1)
 		des := dxs[dir]
-		opt.vopt.ByField.Sort(des)
+		opt.vopt.Sort(des)
 		for _, de := range des {
 			if opt.vopt.Skips.IsSkip(de) {
 				continue
@@ -116,7 +166,12 @@ func (opt *option) viewPaths() error {
 			fmt.Fprintf(w, "%v", vfields.RowStringXNameC(de))
 			fmt.Fprintf(w, "%v\n", rooti+de.FieldC(vfs.ViewFieldName))
 			if hasX && len(de.Xattibutes()) > 0 {
-				vfs.FprintXattrs(w, wdmeta, de.Xattibutes())
+				if opt.isXattrValue {
+					vfs.FprintXattrs(w, wdmeta, de.XattibuteValues())
+				} else {
+					vfs.FprintXattrs(w, wdmeta, de.Xattibutes())
+				}
+				vfs.FprintACL(w, wdmeta, de.ACL())
 				// xrows := vfields.XattibutesRowsSC(de)
 				// for _, row := range xrows {
 				// 	fmt.Fprintf(w, "%s%s\n", "", row)
@@ -155,7 +210,7 @@ func (opt *option) viewPaths() error {
 				continue
 			}
 			fs.BuildFS()
-			fs.View(os.Stdout)
+			fs.View(opt.out)
 			nd, nf, _ = fs.RootDir().NItems(true)
 			tnd += nd
 			tnf += nf
@@ -208,7 +263,7 @@ type demap map[string][]vfs.DirEntryX
 // srmap is map[dir]path
 type srmap map[string]vfs.DirEntryX
 
-func createBasepaths(paths []string) (dxs demap, srm srmap, dirs []string) {
+func createBasepaths(paths []string, vopt *vfs.VFSOption) (dxs demap, srm srmap, dirs []string) {
 	// paw.Logger.Debug()
 	if len(paths) == 0 {
 		return nil, nil, nil
@@ -238,7 +293,7 @@ func createBasepaths(paths []string) (dxs demap, srm srmap, dirs []string) {
 			idx++
 			shortroot = fmt.Sprintf("R%d", idx)
 			sm[dir] = shortroot
-			rde, err := vfs.NewDir(dir, "", nil, nil)
+			rde, err := vfs.NewDir(dir, "", nil, vopt)
 			if err != nil {
 
 			}
@@ -248,9 +303,13 @@ func createBasepaths(paths []string) (dxs demap, srm srmap, dirs []string) {
 		}
 		var de vfs.DirEntryX
 		if info.IsDir() {
-			de, err = vfs.NewDir(path, "", nil, nil)
+			de, err = vfs.NewDir(path, "", nil, vopt)
 		} else {
-			de, err = vfs.NewFile(path, "", nil)
+			var f *vfs.File
+			if f, err = vfs.NewFile(path, "", nil); err == nil {
+				f.SetOption(vopt)
+				de = f
+			}
 		}
 		if err != nil {
 			stderr(err)
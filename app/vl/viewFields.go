@@ -108,6 +108,69 @@ var (
 		Usage:       " list each file's md5 field",
 		Destination: &opt.hasMd5,
 	}
+	fg_hasACL = &cli.BoolFlag{
+		Name:        "acl",
+		Aliases:     []string{"ac"},
+		Value:       false,
+		Usage:       "list each file's POSIX ACL; the full ACL is shown in extended view",
+		Destination: &opt.hasACL,
+	}
+	fg_hasCapability = &cli.BoolFlag{
+		Name:        "capability",
+		Aliases:     []string{"cp"},
+		Value:       false,
+		Usage:       "list each file's capabilities, e.g. cap_net_bind_service+ep",
+		Destination: &opt.hasCapability,
+	}
+	fg_hasContext = &cli.BoolFlag{
+		Name:        "context",
+		Aliases:     []string{"ctx"},
+		Value:       false,
+		Usage:       "list each file's SELinux security context",
+		Destination: &opt.hasContext,
+	}
+	fg_hasMimeType = &cli.BoolFlag{
+		Name:        "mimetype",
+		Aliases:     []string{"mt"},
+		Value:       false,
+		Usage:       "list each file's MIME type detected by content (magic numbers)",
+		Destination: &opt.hasMimeType,
+	}
+	fg_hasFileType = &cli.BoolFlag{
+		Name:        "filetype",
+		Aliases:     []string{"ft"},
+		Value:       false,
+		Usage:       "list each file's type description detected by content (magic numbers)",
+		Destination: &opt.hasFileType,
+	}
+	fg_hasLines = &cli.BoolFlag{
+		Name:        "lines",
+		Aliases:     []string{"lc"},
+		Value:       false,
+		Usage:       "list each text file's line count",
+		Destination: &opt.hasLines,
+	}
+	fg_hasWords = &cli.BoolFlag{
+		Name:        "words",
+		Aliases:     []string{"wc"},
+		Value:       false,
+		Usage:       "list each text file's word count",
+		Destination: &opt.hasWords,
+	}
+	fg_hasEncoding = &cli.BoolFlag{
+		Name:        "encoding",
+		Aliases:     []string{"enc"},
+		Value:       false,
+		Usage:       "list each text file's encoding and BOM, e.g. UTF-8+BOM",
+		Destination: &opt.hasEncoding,
+	}
+	fg_hasIcons = &cli.BoolFlag{
+		Name:        "icons",
+		Aliases:     []string{"ic"},
+		Value:       false,
+		Usage:       "display icons (Nerd Font glyphs) before names",
+		Destination: &opt.hasIcons,
+	}
 
 	fg_hasMTime = &cli.BoolFlag{
 		Name:        "modified",
@@ -145,6 +208,10 @@ var (
 			fg_hasUser, fg_hasGroup,
 			fg_hasMTime, fg_hasATime, fg_hasCTime,
 			fg_hasGit, fg_hasMd5,
+			fg_hasACL, fg_hasCapability, fg_hasContext,
+			fg_hasMimeType, fg_hasFileType,
+			fg_hasLines, fg_hasWords, fg_hasEncoding,
+			fg_hasIcons,
 		},
 		Subcommands: []*cli.Command{
 			{
@@ -288,6 +355,38 @@ func (opt *option) checkViewFields() {
 		isOk = true
 		viewFields |= vfs.ViewFieldGit
 	}
+	if opt.hasACL {
+		isOk = true
+		viewFields |= vfs.ViewFieldACL
+	}
+	if opt.hasCapability {
+		isOk = true
+		viewFields |= vfs.ViewFieldCapability
+	}
+	if opt.hasContext {
+		isOk = true
+		viewFields |= vfs.ViewFieldContext
+	}
+	if opt.hasMimeType {
+		isOk = true
+		viewFields |= vfs.ViewFieldMimeType
+	}
+	if opt.hasFileType {
+		isOk = true
+		viewFields |= vfs.ViewFieldFileType
+	}
+	if opt.hasLines {
+		isOk = true
+		viewFields |= vfs.ViewFieldLines
+	}
+	if opt.hasWords {
+		isOk = true
+		viewFields |= vfs.ViewFieldWords
+	}
+	if opt.hasEncoding {
+		isOk = true
+		viewFields |= vfs.ViewFieldEncoding
+	}
 
 	viewFields |= vfs.ViewFieldName
 	lg.WithFields(logrus.Fields{
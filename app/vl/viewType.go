@@ -1,6 +1,8 @@
 package main
 
 import (
+	"strings"
+
 	"github.com/shyang107/paw"
 	"github.com/shyang107/paw/cast"
 	"github.com/shyang107/paw/vfs"
@@ -53,6 +55,41 @@ var (
 		Usage:       "display type indicator by file names",
 		Destination: &opt.isViewClassify,
 	}
+	fg_isViewGrid = &cli.BoolFlag{
+		Name:        "grid",
+		Aliases:     []string{"gd"},
+		Value:       false,
+		Usage:       "print out in the grid view, listing entries by columns (like as ls -C)",
+		Destination: &opt.isViewGrid,
+	}
+	fg_isGridAcross = &cli.BoolFlag{
+		Name:        "across",
+		Aliases:     []string{"x"},
+		Value:       false,
+		Usage:       "list entries by rows instead of by columns in the grid view (like as ls -x)",
+		Destination: &opt.isGridAcross,
+	}
+	fg_gridField = &cli.StringFlag{
+		Name:        "grid-field",
+		Aliases:     []string{"gf"},
+		Value:       "",
+		Usage:       "show the `field` before names in the grid view: inode, size, blocks, git",
+		Destination: &opt.gridField,
+	}
+	fg_viewName = &cli.StringFlag{
+		Name:        "view",
+		Aliases:     []string{"vn"},
+		Value:       "",
+		Usage:       "print out in the view registered by `name`: " + strings.Join(vfs.ViewNames(), ", "),
+		Destination: &opt.viewName,
+	}
+	fg_outputFormat = &cli.StringFlag{
+		Name:        "format",
+		Aliases:     []string{"fmt"},
+		Value:       "text",
+		Usage:       "output `format`: text (default), md (or markdown), html, svg",
+		Destination: &opt.outputFormat,
+	}
 	fg_isViewX = &cli.BoolFlag{
 		Name:        "extended",
 		Aliases:     []string{"@"},
@@ -60,6 +97,13 @@ var (
 		Usage:       "list each file's extended attributes and sizes",
 		Destination: &opt.isViewX,
 	}
+	fg_isXattrValue = &cli.BoolFlag{
+		Name:        "xattr-value",
+		Aliases:     []string{"xv"},
+		Value:       false,
+		Usage:       "list each file's extended attributes with values (text or hex-escaped binary, truncated), implies --extended",
+		Destination: &opt.isXattrValue,
+	}
 	fg_isViewGroup = &cli.BoolFlag{
 		Name:        "grouped",
 		Aliases:     []string{"G"},
@@ -74,6 +118,20 @@ var (
 		Usage:       "group files and directories separately",
 		Destination: &opt.isViewGroupR,
 	}
+	fg_groupBy = &cli.StringFlag{
+		Name:        "groupby",
+		Aliases:     []string{"gb"},
+		Value:       "",
+		Usage:       "group entries into sections by `key`, each section with its subtotal in list, level and table views: ext, type (detected MIME type), owner, age (today, this week or older) or git; dirs and files are the same as --grouped and --groupedr",
+		Destination: &opt.groupBy,
+	}
+	fg_isMerged = &cli.BoolFlag{
+		Name:        "merge",
+		Aliases:     []string{"mg"},
+		Value:       false,
+		Usage:       "merge several root directories into one table with the column of roots, instead of a view per root",
+		Destination: &opt.isMerged,
+	}
 	fg_isViewNoDirs = &cli.BoolFlag{
 		Name:        "nodirs",
 		Aliases:     []string{"nd"},
@@ -111,6 +169,22 @@ var (
 		Usage:       "anyway, definitely recurse all sub-directories of root",
 		Destination: &opt.isForceRecurse,
 	}
+	// -------------------------------------------
+	// Symlink
+	fg_followMode = &cli.StringFlag{
+		Name:        "follow",
+		Aliases:     []string{"fl"},
+		Value:       "cmdline",
+		Usage:       "which symbolic links to directories are followed: `mode` = none, cmdline (only the root on command line, default), all",
+		Destination: &opt.followMode,
+	}
+	fg_isDereference = &cli.BoolFlag{
+		Name:        "dereference",
+		Aliases:     []string{"L"},
+		Value:       false,
+		Usage:       "follow all symbolic links to directories with detecting loops (equivalent to --follow=all)",
+		Destination: &opt.isDereference,
+	}
 
 	cmd_ViewType = &cli.Command{
 		Name:    "view",
@@ -119,10 +193,13 @@ var (
 		Flags: []cli.Flag{
 			//  ViewType
 			fg_isViewList, fg_isViewLevel, fg_isViewListTree, fg_isViewTree, fg_isViewTable, fg_isViewClassify,
-			fg_isViewX, fg_isViewGroup, fg_isViewGroupR,
+			fg_isViewGrid, fg_isGridAcross, fg_gridField,
+			fg_viewName, fg_outputFormat, fg_isViewX, fg_isXattrValue, fg_isViewGroup, fg_isViewGroupR, fg_groupBy, fg_isMerged,
 			fg_isViewNoDirs, fg_isViewNoFiles,
 			// Depth
 			fg_Depth, fg_IsFindRecurse, fg_isForceRecurse,
+			// Symlink
+			fg_followMode, fg_isDereference,
 		},
 		Subcommands: []*cli.Command{
 			{
@@ -142,6 +219,15 @@ var (
 	}
 )
 
+// outputFormats maps output format to the name of registered view; "" uses the built-in views
+var outputFormats = map[string]string{
+	"text":     "",
+	"md":       "md",
+	"markdown": "md",
+	"html":     "html",
+	"svg":      "svg",
+}
+
 func (opt *option) checkViewType() {
 	lg.Debug(paw.Caller(1))
 	// 1. cehck basic ViewType
@@ -167,17 +253,46 @@ func (opt *option) checkViewType() {
 	if opt.isViewClassify {
 		opt.viewType = vfs.ViewClassify
 	}
+	if opt.isViewGrid {
+		opt.viewType = vfs.ViewGrid
+	}
 	if opt.isViewList {
 		opt.viewType = vfs.ViewList
 	}
+	// view by name: built-in views use ViewType, others are rendered by the registered Renderer
+	if len(opt.viewName) > 0 {
+		name := strings.ToLower(opt.viewName)
+		if vt, ok := vfs.ViewShortNameTypes[name]; ok {
+			if vt&vfs.ViewTree != 0 && opt.depth == 0 {
+				opt.depth = -1
+			}
+			opt.viewType = vt
+			opt.viewName = ""
+		} else if _, ok := vfs.LookupView(name); ok {
+			opt.viewName = name
+		} else {
+			warningf("unknown view %q, use %q (views: %s)\n", opt.viewName, opt.viewType, strings.Join(vfs.ViewNames(), ", "))
+			opt.viewName = ""
+		}
+	}
+
+	// output format: other than text is rendered by the registered view of format with the view type, e.g. --format md --tree
+	if view, ok := outputFormats[strings.ToLower(opt.outputFormat)]; !ok {
+		warningf("unknown output format %q, use \"text\"\n", opt.outputFormat)
+	} else if len(view) > 0 {
+		opt.viewName = view
+	}
 
 	lg.WithField("viewType", opt.viewType).Trace()
 
 	// 2. cehck Extended view
+	if opt.isXattrValue {
+		opt.isViewX = true
+	}
 	if opt.isViewX {
 		hasX = true
 		lg.WithField("isViewX", opt.isViewX).Trace()
-		if opt.viewType&vfs.ViewClassify == 0 {
+		if opt.viewType&(vfs.ViewClassify|vfs.ViewGrid) == 0 {
 			opt.viewType |= vfs.ViewExtended
 		}
 		lg.WithField("> viewType", opt.viewType).Trace()
@@ -197,6 +312,13 @@ func (opt *option) checkViewType() {
 	if !opt.isViewGroup && !opt.isViewGroupR {
 		opt.grouping = vfs.GroupNone
 	}
+	if len(opt.groupBy) > 0 {
+		if g, err := vfs.ParseGroup(opt.groupBy); err != nil {
+			warningf("%v, use one of none, dirs, files, ext, type, owner, age or git\n", err)
+		} else {
+			opt.grouping = g
+		}
+	}
 
 	lg.WithFields(logrus.Fields{
 		"isViewNoDirs":  opt.isViewNoDirs,
@@ -204,7 +326,7 @@ func (opt *option) checkViewType() {
 	}).Trace()
 	if opt.isViewNoDirs && !opt.isViewNoFiles {
 		switch opt.viewType {
-		case vfs.ViewList, vfs.ViewLevel, vfs.ViewTable, vfs.ViewClassify,
+		case vfs.ViewList, vfs.ViewLevel, vfs.ViewTable, vfs.ViewClassify, vfs.ViewGrid,
 			vfs.ViewListX, vfs.ViewLevelX, vfs.ViewTableX:
 			opt.viewType |= vfs.ViewNoDirs
 		}
@@ -213,13 +335,27 @@ func (opt *option) checkViewType() {
 
 	if !opt.isViewNoDirs && opt.isViewNoFiles {
 		switch opt.viewType {
-		case vfs.ViewList, vfs.ViewLevel, vfs.ViewTable, vfs.ViewClassify,
+		case vfs.ViewList, vfs.ViewLevel, vfs.ViewTable, vfs.ViewClassify, vfs.ViewGrid,
 			vfs.ViewListX, vfs.ViewLevelX, vfs.ViewTableX:
 			opt.viewType |= vfs.ViewNoFiles
 		}
 		lg.WithField("> viewType", opt.viewType).Trace()
 	}
 
+	// Grid view
+	lg.WithFields(logrus.Fields{
+		"gridField":    opt.gridField,
+		"isGridAcross": opt.isGridAcross,
+	}).Trace()
+	opt.gridViewField = 0
+	if len(opt.gridField) > 0 {
+		if field, ok := vfs.GridFieldShortNames[strings.ToLower(opt.gridField)]; ok {
+			opt.gridViewField = field
+		} else {
+			warningf("unknown grid field %q, show names only\n", opt.gridField)
+		}
+	}
+
 	// Depth
 	lg.WithField("depth", opt.depth).Trace()
 
@@ -230,9 +366,29 @@ func (opt *option) checkViewType() {
 
 	lg.WithField("IsFindRecurse", opt.IsFindRecurse).Trace()
 
+	// Symlink
+	lg.WithFields(logrus.Fields{
+		"followMode":    opt.followMode,
+		"isDereference": opt.isDereference,
+	}).Trace()
+	opt.follow = vfs.FollowCommandLine
+	if mode, ok := vfs.FollowModeShortNames[strings.ToLower(opt.followMode)]; ok {
+		opt.follow = mode
+	} else {
+		warningf("unknown follow mode %q, use %q\n", opt.followMode, opt.follow)
+	}
+	if opt.isDereference {
+		opt.follow = vfs.FollowAll
+	}
+
 	info(paw.ValuePairA([]*paw.ValuePair{
 		paw.NewValuePair("View type", opt.viewType),
+		paw.NewValuePair("View name", opt.viewName),
+		paw.NewValuePair("Output format", opt.outputFormat),
+		paw.NewValuePair("Grid field", opt.gridViewField),
+		paw.NewValuePair("Grid across", opt.isGridAcross),
 		paw.NewValuePair("Groupe", opt.grouping),
 		paw.NewValuePair("Searching depth", opt.depth),
+		paw.NewValuePair("Follow symlinks", opt.follow),
 	}))
 }
@@ -0,0 +1,126 @@
+package paw
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// HanOrders are the orderings of Han characters of collation (the "co" type of Unicode locale extension): pinyin (by the romanization of Mandarin), zhuyin (by the phonetic symbols of Bopomofo) and stroke (by the number of strokes). zhuyin is not tailored by golang.org/x/text/collate, it uses the order of zhuyinOrder.
+var HanOrders = []string{"pinyin", "zhuyin", "stroke"}
+
+// Collator compares strings by the Unicode Collation Algorithm with the tailoring of a locale (use golang.org/x/text/collate); it is safe for concurrent use.
+type Collator struct {
+	tag    language.Tag
+	mu     sync.Mutex
+	c      *collate.Collator
+	zhuyin bool
+}
+
+// NewCollator returns the collator of `locale`, a BCP 47 tag (e.g. "zh-TW", "ja" or "zh-u-co-pinyin") or a POSIX locale (e.g. "zh_TW.UTF-8"); the empty locale is the locale of environment (see CollateLocale), and "C" or "POSIX" is the root collation. `hanOrder` (one of HanOrders, or "" for the default of locale) orders Han characters by the Chinese collation of it, the region of locale is kept if the language is Chinese.
+func NewCollator(locale, hanOrder string) (*Collator, error) {
+	if len(locale) == 0 {
+		locale = CollateLocale()
+	}
+	tag, err := language.Parse(normalizeLocale(locale))
+	if err != nil {
+		return nil, fmt.Errorf("collation locale %q: %v", locale, err)
+	}
+	if len(hanOrder) > 0 {
+		hanOrder = strings.ToLower(hanOrder)
+		if !isHanOrder(hanOrder) {
+			return nil, fmt.Errorf("collation of Han characters %q: use one of %s", hanOrder, strings.Join(HanOrders, ", "))
+		}
+		if base, _ := tag.Base(); base.String() != "zh" {
+			tag = language.Chinese
+		}
+		if tag, err = tag.SetTypeForKey("co", hanOrder); err != nil {
+			return nil, fmt.Errorf("collation of Han characters %q: %v", hanOrder, err)
+		}
+	}
+	return &Collator{
+		tag:    tag,
+		c:      collate.New(tag, collate.OptionsFromTag(tag)),
+		zhuyin: hanOrder == "zhuyin",
+	}, nil
+}
+
+func (c *Collator) String() string {
+	return c.tag.String()
+}
+
+// Compare returns -1, 0 or +1 as `a` is less than, equal to or greater than `b` in the collation order of c
+func (c *Collator) Compare(a, b string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.zhuyin {
+		return c.c.CompareString(zhuyinKey(a), zhuyinKey(b))
+	}
+	return c.c.CompareString(a, b)
+}
+
+// Less reports whether `a` is less than `b` in the collation order of c
+func (c *Collator) Less(a, b string) bool {
+	return c.Compare(a, b) < 0
+}
+
+// CollateLocale returns the locale of collation of environment, i.e. the first non-empty one of LC_ALL, LC_COLLATE and LANG; it is "C" if none is set.
+func CollateLocale() string {
+	for _, env := range []string{"LC_ALL", "LC_COLLATE", "LANG"} {
+		if locale := os.Getenv(env); len(locale) > 0 {
+			return locale
+		}
+	}
+	return "C"
+}
+
+// normalizeLocale converts POSIX locale `locale` (e.g. "zh_TW.UTF-8" or "de_DE@euro") to BCP 47 tag (e.g. "zh-TW"); "C" and "POSIX" are "und", the root collation.
+func normalizeLocale(locale string) string {
+	if i := strings.IndexAny(locale, ".@"); i >= 0 {
+		locale = locale[:i]
+	}
+	switch locale {
+	case "", "C", "POSIX":
+		return "und"
+	}
+	return strings.ReplaceAll(locale, "_", "-")
+}
+
+func isHanOrder(order string) bool {
+	for _, o := range HanOrders {
+		if o == order {
+			return true
+		}
+	}
+	return false
+}
+
+// zhuyinRuneBase is the first rune of the private use runes (plane 15) which stand for the Han characters of zhuyinOrder in zhuyinKey. The collation orders them by code point, after letters and Bopomofo.
+const zhuyinRuneBase = 0xF0000
+
+var (
+	zhuyinWeights     map[rune]int
+	zhuyinWeightsOnce sync.Once
+)
+
+// zhuyinKey replaces the Han characters of `s` listed in zhuyinOrder with the private use runes of their order, so that the collation sorts them in zhuyin order; the other Han characters keep the order of locale.
+func zhuyinKey(s string) string {
+	zhuyinWeightsOnce.Do(func() {
+		zhuyinWeights = make(map[rune]int)
+		for _, r := range zhuyinOrder {
+			if r != '\n' {
+				zhuyinWeights[r] = len(zhuyinWeights)
+			}
+		}
+	})
+	return strings.Map(func(r rune) rune {
+		if w, ok := zhuyinWeights[r]; ok {
+			return zhuyinRuneBase + rune(w)
+		}
+		return r
+	}, s)
+}
@@ -0,0 +1,43 @@
+package paw
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCollatorHanOrder(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		order string
+		want  []string
+	}{
+		{"pinyin", []string{"阿", "八", "一", "中"}},
+		{"zhuyin", []string{"八", "中", "阿", "一"}},
+		{"stroke", []string{"一", "八", "中", "阿"}},
+	}
+	for _, tt := range tests {
+		c, err := NewCollator("zh-TW", tt.order)
+		assert.NoError(err, tt.order)
+		names := []string{"一", "八", "中", "阿"}
+		sort.Slice(names, func(i, j int) bool { return c.Compare(names[i], names[j]) < 0 })
+		assert.Equal(tt.want, names, tt.order)
+	}
+
+	_, err := NewCollator("zh-TW", "cangjie")
+	assert.Error(err)
+}
+
+func TestCollatorZhuyin(t *testing.T) {
+	assert := assert.New(t)
+
+	c, err := NewCollator("zh-TW", "zhuyin")
+	assert.NoError(err)
+	// ㄅ (八, 巴), ㄆ (怕), ㄇ (媽), and names with letters, digits and Han characters
+	names := []string{"媽媽.txt", "怕", "巴士", "八", "b", "a中", "ab", "10", "2"}
+	sort.Slice(names, func(i, j int) bool { return c.Less(names[i], names[j]) })
+	assert.Equal([]string{"10", "2", "ab", "a中", "b", "八", "巴士", "怕", "媽媽.txt"}, names)
+	assert.Equal(0, c.Compare("八", "八"))
+}
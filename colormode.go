@@ -0,0 +1,180 @@
+package paw
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/mattn/go-isatty"
+)
+
+func init() {
+	// colors are produced even if stdout is not a terminal, NewColorWriter decides whether output to a writer is colored
+	color.NoColor = NoColor
+}
+
+// ColorMode is the policy of coloring output to a writer (like as `ls --color=WHEN`)
+type ColorMode int
+
+const (
+	// ColorAuto colors output only to terminal, respecting NO_COLOR, CLICOLOR and CLICOLOR_FORCE
+	ColorAuto ColorMode = iota
+	// ColorAlways always colors output
+	ColorAlways
+	// ColorNever never colors output
+	ColorNever
+)
+
+// ColorModeNames are the names of ColorMode
+var ColorModeNames = map[ColorMode]string{
+	ColorAuto:   "auto",
+	ColorAlways: "always",
+	ColorNever:  "never",
+}
+
+// colorModeValues maps the names (and the synonyms of GNU ls) to ColorMode
+var colorModeValues = map[string]ColorMode{
+	"":       ColorAuto,
+	"auto":   ColorAuto,
+	"tty":    ColorAuto,
+	"if-tty": ColorAuto,
+	"always": ColorAlways,
+	"yes":    ColorAlways,
+	"force":  ColorAlways,
+	"never":  ColorNever,
+	"no":     ColorNever,
+	"none":   ColorNever,
+}
+
+func (m ColorMode) String() string {
+	if name, ok := ColorModeNames[m]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseColorMode returns the ColorMode of `name`: auto, always or never (the synonyms of GNU ls are accepted, e.g. tty, yes, no)
+func ParseColorMode(name string) (ColorMode, error) {
+	if m, ok := colorModeValues[strings.ToLower(strings.TrimSpace(name))]; ok {
+		return m, nil
+	}
+	return ColorAuto, fmt.Errorf("invalid color mode %q, use auto, always or never", name)
+}
+
+// IsColorWriter reports whether output to w is colored under mode m. In ColorAuto, it is not colored if NO_COLOR is set (https://no-color.org), colored if CLICOLOR_FORCE is set other than "0", not colored if CLICOLOR is "0", and otherwise colored only if w is a terminal.
+func (m ColorMode) IsColorWriter(w io.Writer) bool {
+	switch m {
+	case ColorAlways:
+		return true
+	case ColorNever:
+		return false
+	}
+	if len(os.Getenv("NO_COLOR")) > 0 {
+		return false
+	}
+	if force := os.Getenv("CLICOLOR_FORCE"); len(force) > 0 && force != "0" {
+		return true
+	}
+	if os.Getenv("CLICOLOR") == "0" {
+		return false
+	}
+	return IsTerminal(w)
+}
+
+// IsTerminal reports whether w is a terminal (not "dumb")
+func IsTerminal(w io.Writer) bool {
+	f, ok := w.(interface{ Fd() uintptr })
+	if !ok || os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	return isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd())
+}
+
+// NewColorWriter returns w if output to w is colored under mode m (see ColorMode.IsColorWriter), otherwise a writer removing the escape sequences of ANSI (CSI and OSC) written to w. The returned writer keeps the Fd() of w, so it can still be checked by IsTerminal.
+//
+// Colors are produced by default (NoColor is false) and decided per writer, so colored and plain output can be rendered concurrently.
+func NewColorWriter(w io.Writer, m ColorMode) io.Writer {
+	if m.IsColorWriter(w) {
+		return w
+	}
+	switch w.(type) {
+	case *ansiStripWriter, *ansiStripFileWriter:
+		return w
+	}
+	s := &ansiStripWriter{w: w}
+	if f, ok := w.(interface{ Fd() uintptr }); ok {
+		return &ansiStripFileWriter{s, f}
+	}
+	return s
+}
+
+// states of ansiStripWriter
+const (
+	ansiText = iota
+	ansiEsc
+	ansiCSI
+	ansiOSC
+	ansiOSCEsc
+)
+
+// ansiStripWriter removes the escape sequences of ANSI written to w; the state is kept between writes, so sequences split across writes are removed too.
+type ansiStripWriter struct {
+	w     io.Writer
+	state int
+}
+
+// ansiStripFileWriter is the ansiStripWriter of a file (e.g. os.Stdout), which keeps Fd() of the file
+type ansiStripFileWriter struct {
+	*ansiStripWriter
+	f interface{ Fd() uintptr }
+}
+
+// Fd returns the file descriptor of the underlying file
+func (s *ansiStripFileWriter) Fd() uintptr {
+	return s.f.Fd()
+}
+
+func (s *ansiStripWriter) Write(p []byte) (int, error) {
+	buf := make([]byte, 0, len(p))
+	for _, b := range p {
+		switch s.state {
+		case ansiText:
+			if b == 0x1b {
+				s.state = ansiEsc
+			} else {
+				buf = append(buf, b)
+			}
+		case ansiEsc:
+			switch b {
+			case '[':
+				s.state = ansiCSI
+			case ']':
+				s.state = ansiOSC
+			default: // two-byte sequence
+				s.state = ansiText
+			}
+		case ansiCSI:
+			if b >= 0x40 && b <= 0x7e {
+				s.state = ansiText
+			}
+		case ansiOSC:
+			if b == 0x07 {
+				s.state = ansiText
+			} else if b == 0x1b {
+				s.state = ansiOSCEsc
+			}
+		case ansiOSCEsc:
+			if b == '\\' {
+				s.state = ansiText
+			} else {
+				s.state = ansiOSC
+			}
+		}
+	}
+	if _, err := s.w.Write(buf); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
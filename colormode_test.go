@@ -0,0 +1,58 @@
+package paw
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnsiStripWriter(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		name   string
+		writes []string
+		want   string
+	}{
+		{"plain", []string{"abc"}, "abc"},
+		{"CSI", []string{"\x1b[1;31mred\x1b[0m"}, "red"},
+		{"split CSI", []string{"a\x1b[3", "8;5;1", "56mb\x1b", "[0m"}, "ab"},
+		{"OSC by BEL", []string{"\x1b]8;;file:///tmp\x07link\x1b]8;;\x07"}, "link"},
+		{"split OSC by ST", []string{"a\x1b]8;;x\x1b", "\\b\x1b]8;;\x1b\\c"}, "abc"},
+		{"two-byte", []string{"a\x1b7b\x1b8"}, "ab"},
+	}
+	for _, tt := range tests {
+		buf := new(bytes.Buffer)
+		w := NewColorWriter(buf, ColorNever)
+		for _, s := range tt.writes {
+			n, err := w.Write([]byte(s))
+			assert.NoError(err, tt.name)
+			assert.Equal(len(s), n, tt.name)
+		}
+		assert.Equal(tt.want, buf.String(), tt.name)
+	}
+
+	buf := new(bytes.Buffer)
+	w := NewColorWriter(buf, ColorNever)
+	assert.Equal(w, NewColorWriter(w, ColorNever))
+	assert.Equal(buf, NewColorWriter(buf, ColorAlways))
+	_, ok := w.(interface{ Fd() uintptr })
+	assert.False(ok)
+
+	// the writer of a file keeps its Fd
+	f, err := os.CreateTemp(t.TempDir(), "out")
+	assert.NoError(err)
+	defer f.Close()
+	fw := NewColorWriter(f, ColorNever)
+	if assert.Implements((*interface{ Fd() uintptr })(nil), fw) {
+		assert.Equal(f.Fd(), fw.(interface{ Fd() uintptr }).Fd())
+	}
+	assert.Equal(fw, NewColorWriter(fw, ColorNever))
+	_, err = fw.Write([]byte("\x1b[1mbold\x1b[0m"))
+	assert.NoError(err)
+	b, err := os.ReadFile(f.Name())
+	assert.NoError(err)
+	assert.Equal("bold", string(b))
+}
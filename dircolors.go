@@ -0,0 +1,304 @@
+package paw
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/xattr"
+)
+
+// LSColorPattern is a pattern of file names of LS_COLORS (the key prefixed by "*", e.g. "*.tar.gz" or "*README*") and its color
+type LSColorPattern struct {
+	Pattern string
+	Attrs   []Attribute
+}
+
+var (
+	// LSColorPatterns are the patterns of file names from LS_COLORS, dircolors databases, EXA_COLORS and themes; they take precedence over the names and extensions of LSColorAttributes, and the later patterns take precedence over the earlier ones (like as `ls`).
+	LSColorPatterns = []LSColorPattern{}
+	// LSColorLinkTarget colors symbolic links by their targets (ln=target)
+	LSColorLinkTarget bool
+)
+
+// SetLSColorPattern sets the color of file names matching `pattern`, e.g. "*.go", "*~" or "*README*"; a pattern set again moves to the last.
+func SetLSColorPattern(pattern string, attrs []Attribute) {
+	for i, p := range LSColorPatterns {
+		if p.Pattern == pattern {
+			LSColorPatterns = append(LSColorPatterns[:i], LSColorPatterns[i+1:]...)
+			break
+		}
+	}
+	LSColorPatterns = append(LSColorPatterns, LSColorPattern{Pattern: pattern, Attrs: attrs})
+}
+
+// MatchLSColorPattern returns the color of the last pattern of LSColorPatterns matching file `name`: "*suffix" matches the suffix of name (case-sensitively first, then case-insensitively, like as `ls`), and the other patterns are matched by path.Match.
+func MatchLSColorPattern(name string) ([]Attribute, bool) {
+	lname := strings.ToLower(name)
+	for _, fold := range []bool{false, true} {
+		for i := len(LSColorPatterns) - 1; i >= 0; i-- {
+			p := LSColorPatterns[i]
+			suffix := strings.TrimPrefix(p.Pattern, "*")
+			if strings.HasPrefix(p.Pattern, "*") && !strings.ContainsAny(suffix, "*?[") {
+				if (!fold && strings.HasSuffix(name, suffix)) ||
+					(fold && strings.HasSuffix(lname, strings.ToLower(suffix))) {
+					return p.Attrs, true
+				}
+				continue
+			}
+			if fold {
+				continue
+			}
+			if ok, _ := path.Match(p.Pattern, name); ok {
+				return p.Attrs, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// isLSColorKindSet returns true if the kind of file `key` of LSColorAttributes is set and not plain (e.g. "su=0" or "su=" disables it)
+func isLSColorKindSet(key string) bool {
+	att, ok := LSColorAttributes[key]
+	if !ok || len(att) == 0 {
+		return false
+	}
+	return !(len(att) == 1 && att[0] == 0)
+}
+
+// LSColorKindOf returns the key of kind of file of LS_COLORS by `mode`, e.g. "di", "tw", "ln", "or", "su", "ca" or "ex", like as `ls`: the kinds of directory (tw, ow, st) and of regular file (su, sg, ca, ex) are used only if their colors are set, and "or" falls back to "ln". isOrphan is true for a symbolic link to a non-existent file, hasCap is true for a file with capabilities. It returns "" for a regular file colored by its name.
+func LSColorKindOf(mode fs.FileMode, isOrphan, hasCap bool) string {
+	switch {
+	case mode&fs.ModeSymlink != 0:
+		if isOrphan && isLSColorKindSet("or") {
+			return "or"
+		}
+		return "ln"
+	case mode.IsDir():
+		var (
+			isSticky = mode&fs.ModeSticky != 0
+			isOW     = mode&0002 != 0
+		)
+		switch {
+		case isSticky && isOW && isLSColorKindSet("tw"):
+			return "tw"
+		case isOW && isLSColorKindSet("ow"):
+			return "ow"
+		case isSticky && isLSColorKindSet("st"):
+			return "st"
+		}
+		return "di"
+	case mode&fs.ModeNamedPipe != 0:
+		return "pi"
+	case mode&fs.ModeSocket != 0:
+		return "so"
+	case mode&fs.ModeCharDevice != 0:
+		return "cd"
+	case mode&fs.ModeDevice != 0:
+		return "bd"
+	case mode&fs.ModeSetuid != 0 && isLSColorKindSet("su"):
+		return "su"
+	case mode&fs.ModeSetgid != 0 && isLSColorKindSet("sg"):
+		return "sg"
+	case hasCap && isLSColorKindSet("ca"):
+		return "ca"
+	case mode&0111 != 0 && isLSColorKindSet("ex"):
+		return "ex"
+	}
+	return ""
+}
+
+// LSKindColor returns the color of kind of file `kind` (see LSColorKindOf), using the default colors (e.g. Cdip, Clnp) for the common kinds
+func LSKindColor(kind string) *Color {
+	switch kind {
+	case "di":
+		return Cdip
+	case "ln":
+		return Clnp
+	case "or":
+		return Corp
+	case "ex":
+		return Cexp
+	case "pi":
+		return Cpip
+	case "so":
+		return Csop
+	case "cd":
+		return Ccdp
+	case "bd":
+		return Cbdp
+	}
+	return NewLSColor(kind)
+}
+
+// NameLSColorAttributes returns the color of file `name` by LSColorPatterns and then the names, extensions and patterns of extensions (ReExtLSColors) of LSColorAttributes
+func NameLSColorAttributes(name string) ([]Attribute, bool) {
+	if att, ok := MatchLSColorPattern(name); ok {
+		return att, true
+	}
+	if att, ok := LSColorAttributes[name]; ok {
+		return att, true
+	}
+	ext := filepath.Ext(name)
+	if att, ok := LSColorAttributes[ext]; ok {
+		return att, true
+	}
+	file := strings.TrimSuffix(name, ext)
+	if att, ok := LSColorAttributes[file]; ok {
+		return att, true
+	}
+	for re, att := range ReExtLSColors {
+		if re.MatchString(name) {
+			return att, true
+		}
+	}
+	return nil, false
+}
+
+// hasCapability returns true if file `path` has capabilities (security.capability of extended attributes)
+func hasCapability(path string) bool {
+	_, err := xattr.LGet(path, "security.capability")
+	return err == nil
+}
+
+// SetLSColors applies `lscolors` in the format of LS_COLORS (e.g. "di=01;34:ln=target:*.go=38;5;81"): the kinds of file are set to LSColorAttributes (and EXAColorAttributes), the "*" keys are set to LSColorPatterns. The built-in colors of names and extensions are removed, so that the names are colored as `ls` does.
+func SetLSColors(lscolors string) {
+	if len(strings.Trim(lscolors, ": ")) == 0 {
+		return
+	}
+	for k := range LSColorAttributes {
+		if !lsColorKinds[k] {
+			delete(LSColorAttributes, k)
+		}
+	}
+	ReExtLSColors = map[*regexp.Regexp][]Attribute{}
+	for _, a := range strings.Split(lscolors, ":") {
+		kv := strings.SplitN(a, "=", 2)
+		if len(kv) != 2 || len(kv[0]) == 0 {
+			continue
+		}
+		if kv[0] == "ln" && kv[1] == "target" {
+			LSColorLinkTarget = true
+			continue
+		}
+		setColorAttribute(kv[0], getLSColorAttribute(kv[1]), true)
+	}
+	ReloadColors()
+}
+
+// lsColorKinds are the keys of kinds of file (and the codes of terminal) of LS_COLORS
+var lsColorKinds = map[string]bool{
+	"no": true, "fi": true, "rs": true, "di": true, "ln": true, "mh": true,
+	"pi": true, "so": true, "do": true, "bd": true, "cd": true, "or": true,
+	"mi": true, "su": true, "sg": true, "ca": true, "tw": true, "ow": true,
+	"st": true, "ex": true, "lc": true, "rc": true, "ec": true, "cl": true,
+}
+
+// dircolorsKeywords maps the keywords of dircolors database to the keys of LS_COLORS
+var dircolorsKeywords = map[string]string{
+	"NORMAL": "no", "NORM": "no", "FILE": "fi", "RESET": "rs",
+	"DIR": "di", "LNK": "ln", "LINK": "ln", "SYMLINK": "ln",
+	"ORPHAN": "or", "MISSING": "mi", "FIFO": "pi", "PIPE": "pi",
+	"SOCK": "so", "BLK": "bd", "BLOCK": "bd", "CHR": "cd", "CHAR": "cd",
+	"DOOR": "do", "EXEC": "ex", "LEFT": "lc", "LEFTCODE": "lc",
+	"RIGHT": "rc", "RIGHTCODE": "rc", "END": "ec", "ENDCODE": "ec",
+	"SUID": "su", "SETUID": "su", "SGID": "sg", "SETGID": "sg",
+	"STICKY": "st", "OTHER_WRITABLE": "ow", "OWR": "ow",
+	"STICKY_OTHER_WRITABLE": "tw", "OWT": "tw", "CAPABILITY": "ca",
+	"MULTIHARDLINK": "mh", "CLRTOEOL": "cl",
+}
+
+// states of TERM (and COLORTERM) blocks of dircolors database
+const (
+	dcGlobal   = iota // no TERM yet
+	dcTermSure        // in the TERM lines, matched
+	dcTermYes         // after the matched TERM lines
+	dcTermNo          // not matched
+)
+
+// ParseDircolors parses the dircolors database (see `dircolors --print-database`) from r and returns it in the format of LS_COLORS; the entries are used only under the TERM (or COLORTERM) lines matching `term` (or `colorterm`), like as `dircolors`.
+func ParseDircolors(r io.Reader, term, colorterm string) (string, error) {
+	var (
+		entries = []string{}
+		state   = dcGlobal
+		scanner = bufio.NewScanner(r)
+		nline   int
+	)
+	for scanner.Scan() {
+		nline++
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) < 2 {
+			return "", fmt.Errorf("line %d: missing argument of %q", nline, fields[0])
+		}
+		keyword, arg := fields[0], fields[1]
+		switch strings.ToUpper(keyword) {
+		case "TERM", "COLORTERM":
+			if state != dcTermSure {
+				value := term
+				if strings.EqualFold(keyword, "COLORTERM") {
+					value = colorterm
+				}
+				state = dcTermNo
+				if ok, _ := path.Match(arg, value); ok {
+					state = dcTermSure
+				}
+			}
+			continue
+		}
+		if state == dcTermSure {
+			state = dcTermYes
+		}
+		if state == dcTermNo {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(keyword, "*"):
+			entries = append(entries, keyword+"="+arg)
+		case strings.HasPrefix(keyword, "."):
+			entries = append(entries, "*"+keyword+"="+arg)
+		default:
+			key, ok := dircolorsKeywords[strings.ToUpper(keyword)]
+			if !ok {
+				// OPTIONS, COLOR, EIGHTBIT and unknown keywords
+				continue
+			}
+			entries = append(entries, key+"="+arg)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return strings.Join(entries, ":"), nil
+}
+
+// LoadDircolors reads the dircolors database `path` for the terminal of TERM and COLORTERM, and applies it (see SetLSColors)
+func LoadDircolors(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	lscolors, err := ParseDircolors(f, os.Getenv("TERM"), os.Getenv("COLORTERM"))
+	if err != nil {
+		return &fs.PathError{Op: "LoadDircolors", Path: path, Err: err}
+	}
+	if len(lscolors) == 0 {
+		return &fs.PathError{Op: "LoadDircolors", Path: path, Err: errors.New("no color for the terminal")}
+	}
+	SetLSColors(lscolors)
+	return nil
+}
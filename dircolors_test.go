@@ -0,0 +1,44 @@
+package paw
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDircolors(t *testing.T) {
+	assert := assert.New(t)
+
+	db := `# global entries
+NORMAL 00 # no color
+.tar 01;31
+COLOR tty
+
+TERM xterm*
+TERM linux
+DIR 01;34
+*README 33
+
+COLORTERM truecolor
+LINK 01;36
+`
+	tests := []struct {
+		term, colorterm string
+		want            string
+	}{
+		{"xterm-256color", "", "no=00:*.tar=01;31:di=01;34:*README=33"},
+		{"linux", "", "no=00:*.tar=01;31:di=01;34:*README=33"},
+		{"dumb", "", "no=00:*.tar=01;31"},
+		{"dumb", "truecolor", "no=00:*.tar=01;31:ln=01;36"},
+		{"xterm", "truecolor", "no=00:*.tar=01;31:di=01;34:*README=33:ln=01;36"},
+	}
+	for _, tt := range tests {
+		lscolors, err := ParseDircolors(strings.NewReader(db), tt.term, tt.colorterm)
+		assert.NoError(err, tt.term)
+		assert.Equal(tt.want, lscolors, "TERM=%s COLORTERM=%s", tt.term, tt.colorterm)
+	}
+
+	_, err := ParseDircolors(strings.NewReader("NORMAL 00\nDIR\n"), "xterm", "")
+	assert.Error(err)
+}
@@ -0,0 +1,142 @@
+package paw
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// HyperlinkMode is the policy of emitting OSC 8 hyperlinks (like as `ls --hyperlink=WHEN`)
+type HyperlinkMode int
+
+const (
+	// HyperlinkAuto emits hyperlinks only to terminal supporting them (see IsHyperlinkTerminal)
+	HyperlinkAuto HyperlinkMode = iota
+	// HyperlinkAlways always emits hyperlinks
+	HyperlinkAlways
+	// HyperlinkNever never emits hyperlinks
+	HyperlinkNever
+)
+
+// HyperlinkModeNames are the names of HyperlinkMode
+var HyperlinkModeNames = map[HyperlinkMode]string{
+	HyperlinkAuto:   "auto",
+	HyperlinkAlways: "always",
+	HyperlinkNever:  "never",
+}
+
+// hyperlinkModeValues maps the names (and the synonyms of GNU ls) to HyperlinkMode
+var hyperlinkModeValues = map[string]HyperlinkMode{
+	"":       HyperlinkAuto,
+	"auto":   HyperlinkAuto,
+	"tty":    HyperlinkAuto,
+	"if-tty": HyperlinkAuto,
+	"always": HyperlinkAlways,
+	"yes":    HyperlinkAlways,
+	"force":  HyperlinkAlways,
+	"never":  HyperlinkNever,
+	"no":     HyperlinkNever,
+	"none":   HyperlinkNever,
+}
+
+func (m HyperlinkMode) String() string {
+	if name, ok := HyperlinkModeNames[m]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseHyperlinkMode returns the HyperlinkMode of `name`: auto, always or never (the synonyms of GNU ls are accepted, e.g. tty, yes, no)
+func ParseHyperlinkMode(name string) (HyperlinkMode, error) {
+	if m, ok := hyperlinkModeValues[strings.ToLower(strings.TrimSpace(name))]; ok {
+		return m, nil
+	}
+	return HyperlinkAuto, fmt.Errorf("invalid hyperlink mode %q, use auto, always or never", name)
+}
+
+// IsHyperlinkWriter reports whether hyperlinks are emitted to w under mode m; in HyperlinkAuto, only if w is a terminal supporting them.
+func (m HyperlinkMode) IsHyperlinkWriter(w io.Writer) bool {
+	switch m {
+	case HyperlinkAlways:
+		return true
+	case HyperlinkNever:
+		return false
+	}
+	return IsTerminal(w) && IsHyperlinkTerminal()
+}
+
+// IsHyperlinkTerminal guesses whether the terminal supports OSC 8 hyperlinks by environment: FORCE_HYPERLINK ("0" or not) decides it if it is set, otherwise the terminals known to support them are detected (e.g. iTerm2, WezTerm, kitty, VTE-based terminals, Windows Terminal, Konsole and VS Code).
+func IsHyperlinkTerminal() bool {
+	if force, ok := os.LookupEnv("FORCE_HYPERLINK"); ok {
+		return force != "0"
+	}
+	switch os.Getenv("TERM_PROGRAM") {
+	case "iTerm.app", "WezTerm", "vscode", "Hyper", "ghostty":
+		return true
+	}
+	if vte, err := strconv.Atoi(os.Getenv("VTE_VERSION")); err == nil && vte >= 5000 {
+		return true
+	}
+	for _, env := range []string{"KITTY_WINDOW_ID", "WT_SESSION", "KONSOLE_VERSION", "DOMTERM"} {
+		if len(os.Getenv(env)) > 0 {
+			return true
+		}
+	}
+	term := os.Getenv("TERM")
+	for _, t := range []string{"kitty", "alacritty", "foot", "wezterm", "ghostty"} {
+		if strings.Contains(term, t) {
+			return true
+		}
+	}
+	return false
+}
+
+// NoHyperlink disables the hyperlinks of Hyperlink, default is true (see EnableHyperlink)
+var NoHyperlink = true
+
+// EnableHyperlink enables the hyperlinks of Hyperlink
+func EnableHyperlink() {
+	NoHyperlink = false
+}
+
+// DisableHyperlink disables the hyperlinks of Hyperlink
+func DisableHyperlink() {
+	NoHyperlink = true
+}
+
+// Hyperlink returns `text` wrapped in the OSC 8 hyperlink to `uri`, or `text` itself if NoHyperlink is true; the escape sequences are ignored by StripANSI and the widths of aligning.
+func Hyperlink(uri, text string) string {
+	if NoHyperlink || len(uri) == 0 {
+		return text
+	}
+	return "\x1b]8;;" + uri + "\x1b\\" + text + "\x1b]8;;\x1b\\"
+}
+
+// HyperlinkPath returns `text` wrapped in the hyperlink to the file of `path` (see FileURL and Hyperlink)
+func HyperlinkPath(path, text string) string {
+	if NoHyperlink {
+		return text
+	}
+	return Hyperlink(FileURL(path), text)
+}
+
+var hostname, _ = os.Hostname()
+
+// FileURL returns the URL of file `path`, file://host/abs/path, with the path escaped
+func FileURL(path string) string {
+	if !filepath.IsAbs(path) {
+		if abs, err := filepath.Abs(path); err == nil {
+			path = abs
+		}
+	}
+	u := url.URL{
+		Scheme: "file",
+		Host:   hostname,
+		Path:   filepath.ToSlash(path),
+	}
+	return u.String()
+}
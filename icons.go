@@ -0,0 +1,192 @@
+package paw
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// Default icons (Nerd Font glyphs) of files
+var (
+	// IconDir is the default icon of directories
+	IconDir = "\uf115"
+	// IconFile is the default icon of files
+	IconFile = "\uf15b"
+	// IconExec is the default icon of executable files
+	IconExec = "\uf489"
+	// IconLink is the icon of symbolic links to files
+	IconLink = "\uf481"
+	// IconLinkDir is the icon of symbolic links to directories
+	IconLinkDir = "\uf482"
+)
+
+// IconDirNames is the icons of well-known directory names
+var IconDirNames = map[string]string{
+	".git":         "\ue5fb",
+	".github":      "\ue5fd",
+	".config":      "\ue5fc",
+	".vscode":      "\ue70c",
+	"node_modules": "\ue5fa",
+	"Desktop":      "\uf108",
+	"Documents":    "\uf02d",
+	"Downloads":    "\uf498",
+	"Music":        "\uf025",
+	"Pictures":     "\uf03e",
+	"Movies":       "\uf03d",
+	"Videos":       "\uf03d",
+}
+
+// IconNames is the icons of well-known file names, which take precedence over extensions
+var IconNames = map[string]string{
+	".bashrc":            "\uf489",
+	".zshrc":             "\uf489",
+	".profile":           "\uf489",
+	".DS_Store":          "\uf179",
+	".editorconfig":      "\ue615",
+	".gitattributes":     "\uf1d3",
+	".gitignore":         "\uf1d3",
+	".gitmodules":        "\uf1d3",
+	"CMakeLists.txt":     "\ue615",
+	"COPYING":            "\uf718",
+	"Cargo.lock":         "\ue7a8",
+	"Cargo.toml":         "\ue7a8",
+	"Dockerfile":         "\uf308",
+	"docker-compose.yml": "\uf308",
+	"Gemfile":            "\ue21e",
+	"LICENSE":            "\uf718",
+	"Makefile":           "\uf489",
+	"makefile":           "\uf489",
+	"README":             "\uf48a",
+	"README.md":          "\uf48a",
+	"go.mod":             "\ue626",
+	"go.sum":             "\ue626",
+	"package.json":       "\ue718",
+	"package-lock.json":  "\ue718",
+}
+
+// IconExtensions is the icons of file extensions (lower case, including the leading dot)
+var IconExtensions = map[string]string{
+	// source codes
+	".go":    "\ue626",
+	".py":    "\ue606",
+	".rs":    "\ue7a8",
+	".c":     "\ue61e",
+	".h":     "\uf0fd",
+	".cc":    "\ue61d",
+	".cpp":   "\ue61d",
+	".cxx":   "\ue61d",
+	".hpp":   "\uf0fd",
+	".java":  "\ue738",
+	".kt":    "\ue634",
+	".scala": "\ue737",
+	".swift": "\ue755",
+	".js":    "\ue74e",
+	".mjs":   "\ue74e",
+	".ts":    "\ue628",
+	".jsx":   "\ue7ba",
+	".tsx":   "\ue7ba",
+	".rb":    "\ue21e",
+	".php":   "\ue73d",
+	".lua":   "\ue620",
+	".pl":    "\ue769",
+	".r":     "\uf25d",
+	".jl":    "\ue624",
+	".hs":    "\ue777",
+	".ex":    "\ue62d",
+	".exs":   "\ue62d",
+	".erl":   "\ue7b1",
+	".clj":   "\ue768",
+	".dart":  "\ue798",
+	".vim":   "\ue62b",
+	".f":     "\uf1c9",
+	".f90":   "\uf1c9",
+	".sh":    "\uf489",
+	".bash":  "\uf489",
+	".zsh":   "\uf489",
+	".fish":  "\uf489",
+	".diff":  "\uf440",
+	".patch": "\uf440",
+	// web and documents
+	".html": "\ue736",
+	".htm":  "\ue736",
+	".css":  "\ue749",
+	".scss": "\ue749",
+	".md":   "\uf48a",
+	".tex":  "\ue600",
+	".txt":  "\uf15c",
+	".pdf":  "\uf1c1",
+	".doc":  "\uf1c2",
+	".docx": "\uf1c2",
+	".xls":  "\uf1c3",
+	".xlsx": "\uf1c3",
+	".csv":  "\uf1c3",
+	".ppt":  "\uf1c4",
+	".pptx": "\uf1c4",
+	// configurations and data
+	".json": "\ue60b",
+	".yaml": "\ue615",
+	".yml":  "\ue615",
+	".toml": "\ue615",
+	".ini":  "\ue615",
+	".conf": "\ue615",
+	".cfg":  "\ue615",
+	".env":  "\uf462",
+	".xml":  "\ue619",
+	".sql":  "\uf1c0",
+	".db":   "\uf1c0",
+	".lock": "\uf023",
+	".log":  "\uf18d",
+	".key":  "\uf43d",
+	".pem":  "\uf43d",
+	// media
+	".png":  "\uf1c5",
+	".jpg":  "\uf1c5",
+	".jpeg": "\uf1c5",
+	".gif":  "\uf1c5",
+	".bmp":  "\uf1c5",
+	".svg":  "\uf1c5",
+	".ico":  "\uf1c5",
+	".webp": "\uf1c5",
+	".mp3":  "\uf001",
+	".wav":  "\uf001",
+	".flac": "\uf001",
+	".ogg":  "\uf001",
+	".mp4":  "\uf03d",
+	".mkv":  "\uf03d",
+	".mov":  "\uf03d",
+	".avi":  "\uf03d",
+	".ttf":  "\uf031",
+	".otf":  "\uf031",
+	".woff": "\uf031",
+	// archives and packages
+	".zip": "\uf410",
+	".tar": "\uf410",
+	".gz":  "\uf410",
+	".tgz": "\uf410",
+	".bz2": "\uf410",
+	".xz":  "\uf410",
+	".7z":  "\uf410",
+	".rar": "\uf410",
+	".deb": "\ue77d",
+	".rpm": "\ue7bb",
+	".dmg": "\ue271",
+	".iso": "\ue271",
+}
+
+// DirIcon returns the icon of directory `name`
+func DirIcon(name string) string {
+	if icon, ok := IconDirNames[name]; ok {
+		return icon
+	}
+	return IconDir
+}
+
+// FileIcon returns the icon of file `name` by the well-known names (IconNames) and then the extensions (IconExtensions), or IconFile if not found.
+func FileIcon(name string) string {
+	if icon, ok := IconNames[name]; ok {
+		return icon
+	}
+	if icon, ok := IconExtensions[strings.ToLower(filepath.Ext(name))]; ok {
+		return icon
+	}
+	return IconFile
+}
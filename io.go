@@ -11,6 +11,12 @@ import (
 	"os"
 	"os/exec"
 	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	xunicode "golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/encoding/unicode/utf32"
 )
 
 // LineCount counts the number of '\n' for reader `r`
@@ -55,6 +61,97 @@ func FileLineCount(f string) (int, error) {
 	return LineCount(r)
 }
 
+// TextStat is the statistics of text like as `wc`
+type TextStat struct {
+	// Lines is the number of '\n'
+	Lines int
+	// Words is the number of words separated by white spaces
+	Words int
+	// Encoding is the detected encoding, e.g. "ASCII", "UTF-8", "UTF-16LE" or "unknown-8bit"
+	Encoding string
+	// HasBOM is true if text begins with BOM
+	HasBOM bool
+}
+
+// EncodingS returns Encoding with suffix "+BOM" if text has BOM, e.g. "UTF-8+BOM"
+func (t *TextStat) EncodingS() string {
+	if t.HasBOM {
+		return t.Encoding + "+BOM"
+	}
+	return t.Encoding
+}
+
+// textBOMs are the BOM of unicode encodings, UTF-32 must be checked before UTF-16.
+var textBOMs = []struct {
+	bom  string
+	name string
+	enc  encoding.Encoding // nil for UTF-8
+}{
+	{BOM, "UTF-8", nil},
+	{"\xff\xfe\x00\x00", "UTF-32LE", utf32.UTF32(utf32.LittleEndian, utf32.ExpectBOM)},
+	{"\x00\x00\xfe\xff", "UTF-32BE", utf32.UTF32(utf32.BigEndian, utf32.ExpectBOM)},
+	{"\xff\xfe", "UTF-16LE", xunicode.UTF16(xunicode.LittleEndian, xunicode.ExpectBOM)},
+	{"\xfe\xff", "UTF-16BE", xunicode.UTF16(xunicode.BigEndian, xunicode.ExpectBOM)},
+}
+
+// ReadTextStat counts the lines and words of reader `r`, and detects its encoding.
+// 	The leading BOM is trimmed (like as `TrimBOM`) and UTF-16/UTF-32 text is decoded before counting.
+// 	Without BOM, the encoding is "ASCII", "UTF-8" or "unknown-8bit" (invalid UTF-8).
+func ReadTextStat(r io.Reader) (*TextStat, error) {
+	br := bufio.NewReaderSize(r, 32*1024)
+	st := &TextStat{Encoding: "ASCII"}
+	head, _ := br.Peek(4)
+	for _, b := range textBOMs {
+		if bytes.HasPrefix(head, []byte(b.bom)) {
+			st.Encoding, st.HasBOM = b.name, true
+			if b.enc != nil {
+				// the decoder consumes BOM
+				br = bufio.NewReaderSize(b.enc.NewDecoder().Reader(br), 32*1024)
+			} else {
+				br.Discard(len(b.bom))
+			}
+			break
+		}
+	}
+	inWord := false
+	for {
+		c, size, err := br.ReadRune()
+		switch {
+		case err == io.EOF:
+			return st, nil
+		case err != nil:
+			return st, err
+		}
+		switch {
+		case c == utf8.RuneError && size == 1:
+			if !st.HasBOM {
+				st.Encoding = "unknown-8bit"
+			}
+		case c >= utf8.RuneSelf && st.Encoding == "ASCII":
+			st.Encoding = "UTF-8"
+		}
+		if c == '\n' {
+			st.Lines++
+		}
+		if unicode.IsSpace(c) {
+			inWord = false
+		} else if !inWord {
+			inWord = true
+			st.Words++
+		}
+	}
+}
+
+// FileTextStat returns the statistics of text file `f` (see ReadTextStat)
+func FileTextStat(f string) (*TextStat, error) {
+	r, err := os.Open(f)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return ReadTextStat(r)
+}
+
 // ForEachLine higher order function that processes each line of text by callback function.
 // The last non-empty line of input will be processed even if it has no newline.
 // 	`br` : read from `br` reader
@@ -6,9 +6,9 @@ import (
 
 	"regexp"
 	"strings"
+	"unicode"
 
 	"github.com/fatih/color"
-	"github.com/mattn/go-isatty"
 	"github.com/shyang107/paw/cast"
 	"github.com/sirupsen/logrus"
 )
@@ -44,9 +44,9 @@ const (
 )
 
 var (
-	// NoColor check from the type of terminal and
-	// determine output to terminal in color (`true`) or not (`false`)
-	NoColor = os.Getenv("TERM") == "dumb" || !(isatty.IsTerminal(os.Stdout.Fd()) || isatty.IsCygwinTerminal(os.Stdout.Fd()))
+	// NoColor disables the escape sequences of colors (`true`) or not (`false`, default);
+	// whether output is colored is decided per writer, see NewColorWriter
+	NoColor = false
 
 	// LSColorsFileKindDesc ...
 	LSColorsFileKindDesc = map[string]string{
@@ -240,6 +240,14 @@ var (
 		"fatal":    LogLevelColorA(logrus.FatalLevel),
 		"panic":    LogLevelColorA(logrus.PanicLevel),
 		"md5":      LSColorAttributes["no"],
+		"acl":      FgColor256A(180),
+		"cap":      FgColor256A(203).Add(color.Bold),
+		"ctx":      FgColor256A(109),
+		"mime":     FgColor256A(146),
+		"ftype":    FgColor256A(152),
+		"lines":    FgColor256A(186),
+		"words":    FgColor256A(187),
+		"enc":      FgColor256A(117),
 		//LSColorAttributes[".md5"],
 		"field": FgColor256A(216),
 		// {38, 5, 216},
@@ -283,8 +291,8 @@ var (
 		//{48, 5, 196, 38, 5, 232, color.Bold},
 		"ow": FgColor256A(220).Add(color.Bold),
 		//{38, 5, 220, color.Bold},
-		"sg": FgColor256A(0).Add(BgColor256A(0).Add(color.Italic)...),
-		//{48, 5, color.Italic, 38, 5, 0},
+		"sg": FgColor256A(0).Add(BgColor256A(220).Add(color.Italic)...),
+		//{48, 5, 220, color.Italic, 38, 5, 0},
 		"su": FgColor256A(220).Add(color.Bold, color.Italic, color.BgHiBlack),
 		//{38, 5, 220, color.Bold, color.Italic, color.BgHiBlack, color.Bold},
 		"so": FgColor256A(197),
@@ -1419,6 +1427,36 @@ var (
 		regexp.MustCompile(`z[0-9]{0,2}$`): FgColor256A(GraysI[7]),
 		//{38, 5, 239},
 	}
+	// FileTypeLSColorKeys maps MIME type (or its top-level type, e.g. "image/") to the key of LSColorAttributes, used to color files without extension by content
+	FileTypeLSColorKeys = map[string]string{
+		"application/x-executable":                      ".out",
+		"application/x-pie-executable":                  ".out",
+		"application/x-mach-binary":                     ".out",
+		"application/vnd.microsoft.portable-executable": ".out",
+		"application/x-sharedlib":                       ".dylib",
+		"application/x-object":                          ".o",
+		"application/pdf":                               ".pdf",
+		"application/postscript":                        ".ps",
+		"application/vnd.sqlite3":                       ".sqlite",
+		"application/zip":                               ".zip",
+		"application/gzip":                              ".gz",
+		"application/x-bzip2":                           ".bz2",
+		"application/x-xz":                              ".xz",
+		"application/zstd":                              ".zst",
+		"application/x-7z-compressed":                   ".7z",
+		"application/vnd.rar":                           ".rar",
+		"application/x-tar":                             ".tar",
+		"text/x-shellscript":                            ".sh",
+		"text/x-python":                                 ".py",
+		"text/x-perl":                                   ".pl",
+		"text/x-ruby":                                   ".rb",
+		"text/javascript":                               ".js",
+		"text/html":                                     ".html",
+		"text/xml":                                      ".xml",
+		"image/":                                        ".png",
+		"audio/":                                        ".mp3",
+		"video/":                                        ".mp4",
+	}
 
 	// Chdp is default color use for head
 	Chdp = NewEXAColor("hd")
@@ -1456,6 +1494,22 @@ var (
 	Cgitp = NewEXAColor("gm")
 	// Cmd5p is default color use for md5 field
 	Cmd5p = NewEXAColor("md5")
+	// Caclp is default color use for ACL field
+	Caclp = NewEXAColor("acl")
+	// Ccapp is default color use for capabilities field
+	Ccapp = NewEXAColor("cap")
+	// Cctxp is default color use for security context field
+	Cctxp = NewEXAColor("ctx")
+	// Cmimep is default color use for MIME type field
+	Cmimep = NewEXAColor("mime")
+	// Cftypep is default color use for file type (description) field
+	Cftypep = NewEXAColor("ftype")
+	// Clinep is default color use for line count field
+	Clinep = NewEXAColor("lines")
+	// Cwordp is default color use for word count field
+	Cwordp = NewEXAColor("words")
+	// Cencp is default color use for encoding field
+	Cencp = NewEXAColor("enc")
 	// Cxap is default color use for extended attributes
 	Cxap = NewEXAColor("xattr")
 	// Cxbp is default color use for symbole of extended attributes
@@ -1528,13 +1582,48 @@ func CloneColor(color *Color) *Color {
 
 const ansi = "[\u001B\u009B][[\\]()#;?]*(?:(?:(?:[a-zA-Z\\d]*(?:;[a-zA-Z\\d]*)*)?\u0007)|(?:(?:\\d{1,4}(?:;\\d{0,4})*)?[\\dA-PRZcf-ntqry=><~]))"
 
-var reANSI = regexp.MustCompile(ansi)
+// osc8 is the escape sequence of OSC 8 hyperlink, terminated by ST or BEL
+const osc8 = "\u001B\\]8;[^\u0007\u001B]*;[^\u0007\u001B]*(?:\u0007|\u001B\\\\)"
 
-// StripANSI returns a string without ESC color code
+var reANSI = regexp.MustCompile(osc8 + "|" + ansi)
+
+// StripANSI returns a string without ESC color code and OSC 8 hyperlinks
 func StripANSI(str string) string {
 	return reANSI.ReplaceAllString(str, "")
 }
 
+// TrimSpaceANSI returns a string without the leading and trailing white spaces, but keeps ESC color code
+func TrimSpaceANSI(str string) string {
+	var (
+		locs   = reANSI.FindAllStringIndex(str, -1)
+		b      strings.Builder
+		last   int
+		pieces = make([]string, 0, 2*len(locs)+1)
+	)
+	// pieces of text and ESC codes alternately: text, code, text, ..., text
+	for _, loc := range locs {
+		pieces = append(pieces, str[last:loc[0]], str[loc[0]:loc[1]])
+		last = loc[1]
+	}
+	pieces = append(pieces, str[last:])
+	for i := 0; i < len(pieces); i += 2 {
+		pieces[i] = strings.TrimLeftFunc(pieces[i], unicode.IsSpace)
+		if len(pieces[i]) > 0 {
+			break
+		}
+	}
+	for i := len(pieces) - 1; i >= 0; i -= 2 {
+		pieces[i] = strings.TrimRightFunc(pieces[i], unicode.IsSpace)
+		if len(pieces[i]) > 0 {
+			break
+		}
+	}
+	for _, p := range pieces {
+		b.WriteString(p)
+	}
+	return b.String()
+}
+
 // DisableColor will set `true` to `NoColor`
 func DisableColor() {
 	NoColor = true
@@ -1549,21 +1638,13 @@ func EnableColor() {
 
 // DefaultNoColor will resume the default value of `NoColor`
 func DefaultNoColor() {
-	NoColor = os.Getenv("TERM") == "dumb" || !(isatty.IsTerminal(os.Stdout.Fd()) || isatty.IsCygwinTerminal(os.Stdout.Fd()))
+	NoColor = false
 	color.NoColor = NoColor
 }
 
-// GetColors get LS_COLORS from env of os
+// GetLSColors applies LS_COLORS from env of os, see SetLSColors
 func GetLSColors() {
-	colorenv := os.Getenv("LS_COLORS")
-	args := strings.Split(colorenv, ":")
-
-	for _, a := range args {
-		kv := strings.Split(a, "=")
-		if len(kv) == 2 {
-			LSColorAttributes[kv[0]] = getLSColorAttribute(kv[1])
-		}
-	}
+	SetLSColors(os.Getenv("LS_COLORS"))
 }
 
 func getLSColorAttribute(code string) []Attribute {
@@ -1580,7 +1661,7 @@ func KindLSColorString(kind, s string) string {
 	if !ok {
 		att = LSColorAttributes["fi"]
 	}
-	return color.New(att...).Sprint(s)
+	return NewColor(att...).Sprint(s)
 }
 
 func KindEXAColorString(kind, s string) string {
@@ -1588,7 +1669,7 @@ func KindEXAColorString(kind, s string) string {
 	if !ok {
 		att = EXAColorAttributes["fi"]
 	}
-	return color.New(att...).Sprint(s)
+	return NewColor(att...).Sprint(s)
 }
 
 func LogLevelColorA(level logrus.Level) (a []Attribute) {
@@ -1614,72 +1695,77 @@ func LogLevelColor(level logrus.Level) (c *Color) {
 
 // NewLSColor will return `*color.Color` using `LSColorAttributes[key]`
 func NewLSColor(key string) *Color {
-	return color.New(LSColorAttributes[key]...)
+	return NewColor(LSColorAttributes[key]...)
 }
 
 // NewEXAColor will return `*color.Color` using `EXAColorAttributes[key]`
 func NewEXAColor(key string) *Color {
-	return color.New(EXAColorAttributes[key]...)
+	return NewColor(EXAColorAttributes[key]...)
 }
 
-// FileLSColor returns color of file (fullpath) according to LS_COLORS
+// FileLSColor returns color of file (fullpath) according to LS_COLORS: by the kind of file (see LSColorKindOf) and then by the name (see NameLSColorAttributes)
 func FileLSColor(fullpath string) *Color {
 	fi, err := os.Lstat(fullpath)
 	if err != nil {
 		return Cerror
 	}
 
-	if fi.IsDir() { // os.ModeDir
-		return Cdip
-	}
-
-	if fi.Mode()&os.ModeSymlink != 0 { // os.ModeSymlink
+	var (
+		mode     = fi.Mode()
+		isOrphan bool
+		hasCap   bool
+	)
+	if mode&os.ModeSymlink != 0 { // os.ModeSymlink
 		// _, err := filepath.EvalSymlinks(fullpath)
-		_, err := os.Readlink(fullpath)
-		if err != nil {
-			return Corp //NewLSColor("or")
+		_, err := os.Stat(fullpath)
+		isOrphan = err != nil
+		if !isOrphan && LSColorLinkTarget {
+			if target, err := filepath.EvalSymlinks(fullpath); err == nil {
+				return FileLSColor(target)
+			}
 		}
-		return Clnp
-	}
-
-	if fi.Mode()&os.ModeCharDevice != 0 { // os.ModeDevice | os.ModeCharDevice
-		return Ccdp
+	} else if mode.IsRegular() && isLSColorKindSet("ca") {
+		hasCap = hasCapability(fullpath)
 	}
 
-	if fi.Mode()&os.ModeDevice != 0 { //
-		return Cbdp
+	if kind := LSColorKindOf(mode, isOrphan, hasCap); len(kind) > 0 {
+		return LSKindColor(kind)
 	}
 
-	if fi.Mode()&os.ModeNamedPipe != 0 { //os.ModeNamedPipe
-		return Cpip
-	}
-	if fi.Mode()&os.ModeSocket != 0 { //os.ModeSocket
-		return Csop
+	base := filepath.Base(fullpath)
+	if att, ok := NameLSColorAttributes(base); ok {
+		return NewColor(att...)
 	}
-
-	// the file is executable by any of its owner, the group and others, use bitmask 0111
-	if fi.Mode()&0111 != 0 && !fi.IsDir() {
-		return Cexp
+	// extensionless file, e.g. binaries and scripts, is colored by its content (cached, because it's called for every link)
+	if len(filepath.Ext(base)) == 0 && mode.IsRegular() {
+		if ft, err := cachedFileType(fullpath, fi); err == nil {
+			if c := FileTypeLSColor(ft); c != nil {
+				return c
+			}
+		}
 	}
+	return Cfip
+}
 
-	base := filepath.Base(fullpath)
-	if att, ok := LSColorAttributes[base]; ok {
-		return color.New(att...)
+// FileTypeLSColor returns the color of file according to the type detected by content, see FileTypeLSColorKeys. It returns nil if there is no suitable color.
+func FileTypeLSColor(ft *FileType) *Color {
+	if ft == nil {
+		return nil
 	}
-	ext := filepath.Ext(fullpath)
-	if att, ok := LSColorAttributes[ext]; ok {
-		return color.New(att...)
+	mime := strings.TrimSpace(strings.Split(ft.Mime, ";")[0])
+	key, ok := FileTypeLSColorKeys[mime]
+	if !ok {
+		if i := strings.Index(mime, "/"); i > 0 {
+			key, ok = FileTypeLSColorKeys[mime[:i+1]]
+		}
 	}
-	file := strings.TrimSuffix(base, ext)
-	if att, ok := LSColorAttributes[file]; ok {
-		return color.New(att...)
+	if !ok {
+		return nil
 	}
-	for re, att := range ReExtLSColors {
-		if re.MatchString(base) {
-			return color.New(att...)
-		}
+	if att, ok := LSColorAttributes[key]; ok {
+		return NewColor(att...)
 	}
-	return Cfip
+	return nil
 }
 
 // FgGray return foreground gray color (use fatih.color)
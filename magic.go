@@ -0,0 +1,421 @@
+package paw
+
+import (
+	"bytes"
+	"container/list"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// SniffLen is the number of leading bytes of file used to detect its type
+const SniffLen = 512
+
+// FileType is the type of file detected by content (magic numbers)
+type FileType struct {
+	// Mime is MIME type, e.g. "application/x-executable"
+	Mime string
+	// Desc is the description of type, e.g. "ELF 64-bit executable"
+	Desc string
+}
+
+func (f FileType) String() string {
+	return f.Mime
+}
+
+// IsText returns true if content is text
+func (f FileType) IsText() bool {
+	return strings.HasPrefix(f.Mime, "text/")
+}
+
+type magicNumber struct {
+	offset int
+	sig    string
+	mime   string
+	desc   string
+}
+
+// magicNumbers are the signatures of binary files, the first matched is used. The short signatures which can begin text, "MZ" of PE and "BM" of BMP, are checked with their headers (see peType and isBMP).
+var magicNumbers = []magicNumber{
+	// executables
+	{0, "\xfe\xed\xfa\xce", "application/x-mach-binary", "Mach-O 32-bit executable"},
+	{0, "\xce\xfa\xed\xfe", "application/x-mach-binary", "Mach-O 32-bit executable"},
+	{0, "\xfe\xed\xfa\xcf", "application/x-mach-binary", "Mach-O 64-bit executable"},
+	{0, "\xcf\xfa\xed\xfe", "application/x-mach-binary", "Mach-O 64-bit executable"},
+	{0, "\x00asm", "application/wasm", "WebAssembly binary"},
+	// images
+	{0, "\x89PNG\r\n\x1a\n", "image/png", "PNG image"},
+	{0, "\xff\xd8\xff", "image/jpeg", "JPEG image"},
+	{0, "GIF87a", "image/gif", "GIF image"},
+	{0, "GIF89a", "image/gif", "GIF image"},
+	{0, "II*\x00", "image/tiff", "TIFF image (little-endian)"},
+	{0, "MM\x00*", "image/tiff", "TIFF image (big-endian)"},
+	{0, "\x00\x00\x01\x00", "image/vnd.microsoft.icon", "MS Windows icon"},
+	{0, "8BPS", "image/vnd.adobe.photoshop", "Adobe Photoshop image"},
+	// documents
+	{0, "%PDF-", "application/pdf", "PDF document"},
+	{0, "%!PS", "application/postscript", "PostScript document"},
+	{0, "SQLite format 3\x00", "application/vnd.sqlite3", "SQLite 3 database"},
+	// archives
+	{0, "PK\x03\x04", "application/zip", "Zip archive"},
+	{0, "PK\x05\x06", "application/zip", "Zip archive (empty)"},
+	{0, "\x1f\x8b", "application/gzip", "gzip compressed data"},
+	{0, "BZh", "application/x-bzip2", "bzip2 compressed data"},
+	{0, "\xfd7zXZ\x00", "application/x-xz", "XZ compressed data"},
+	{0, "\x28\xb5\x2f\xfd", "application/zstd", "Zstandard compressed data"},
+	{0, "7z\xbc\xaf\x27\x1c", "application/x-7z-compressed", "7-zip archive"},
+	{0, "Rar!\x1a\x07", "application/vnd.rar", "RAR archive"},
+	{0, "!<arch>\n", "application/x-archive", "ar archive"},
+	{257, "ustar", "application/x-tar", "tar archive"},
+	// audio and video
+	{0, "ID3", "audio/mpeg", "MP3 audio (with ID3)"},
+	{0, "OggS", "audio/ogg", "Ogg data"},
+	{0, "fLaC", "audio/flac", "FLAC audio"},
+	{4, "ftypqt", "video/quicktime", "QuickTime video"},
+	{4, "ftyp", "video/mp4", "ISO Media (MP4)"},
+	{0, "\x1a\x45\xdf\xa3", "video/x-matroska", "Matroska data"},
+}
+
+// riffTypes are the form types of RIFF container
+var riffTypes = map[string]magicNumber{
+	"WEBP": {mime: "image/webp", desc: "WebP image"},
+	"WAVE": {mime: "audio/wav", desc: "WAVE audio"},
+	"AVI ": {mime: "video/x-msvideo", desc: "AVI video"},
+}
+
+// scriptTypes maps interpreter of shebang to MIME type
+var scriptTypes = map[string]string{
+	"sh":      "text/x-shellscript",
+	"bash":    "text/x-shellscript",
+	"zsh":     "text/x-shellscript",
+	"ksh":     "text/x-shellscript",
+	"dash":    "text/x-shellscript",
+	"fish":    "text/x-shellscript",
+	"python":  "text/x-python",
+	"python2": "text/x-python",
+	"python3": "text/x-python",
+	"perl":    "text/x-perl",
+	"ruby":    "text/x-ruby",
+	"node":    "text/javascript",
+	"php":     "text/x-php",
+	"lua":     "text/x-lua",
+	"awk":     "text/x-awk",
+	"tclsh":   "text/x-tcl",
+}
+
+// DetectFileType detects the type of file by its content (magic numbers), reading at most SniffLen bytes of file.
+func DetectFileType(path string) (*FileType, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	b := make([]byte, SniffLen)
+	n, err := io.ReadFull(f, b)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil, err
+	}
+	return DetectContentType(b[:n]), nil
+}
+
+// maxCachedFileTypes is the maximum number of file types kept by cachedFileType
+const maxCachedFileTypes = 4096
+
+// fileTypes caches the types of files detected by cachedFileType, keyed by path; the least recently used one is dropped when it's full.
+var fileTypes = newFileTypeCache(maxCachedFileTypes)
+
+// cachedType is the type of file detected with the size and modification time of file at that time
+type cachedType struct {
+	path  string
+	ft    *FileType
+	size  int64
+	mtime time.Time
+}
+
+// fileTypeCache is a LRU cache of cachedType
+type fileTypeCache struct {
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List // front is the most recently used
+	max     int
+}
+
+func newFileTypeCache(max int) *fileTypeCache {
+	return &fileTypeCache{
+		entries: map[string]*list.Element{},
+		order:   list.New(),
+		max:     max,
+	}
+}
+
+func (c *fileTypeCache) get(path string) (cachedType, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[path]
+	if !ok {
+		return cachedType{}, false
+	}
+	c.order.MoveToFront(e)
+	return e.Value.(cachedType), true
+}
+
+func (c *fileTypeCache) put(ct cachedType) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[ct.path]; ok {
+		e.Value = ct
+		c.order.MoveToFront(e)
+		return
+	}
+	c.entries[ct.path] = c.order.PushFront(ct)
+	for c.order.Len() > c.max {
+		e := c.order.Back()
+		c.order.Remove(e)
+		delete(c.entries, e.Value.(cachedType).path)
+	}
+}
+
+// cachedFileType returns the type of file `path` (fi is its information) by DetectFileType; the type is cached by path, and detected again if the size or modification time of file is changed.
+func cachedFileType(path string, fi os.FileInfo) (*FileType, error) {
+	c, ok := fileTypes.get(path)
+	if ok && c.size == fi.Size() && c.mtime.Equal(fi.ModTime()) {
+		return c.ft, nil
+	}
+	ft, err := DetectFileType(path)
+	if err != nil {
+		return nil, err
+	}
+	fileTypes.put(cachedType{path, ft, fi.Size(), fi.ModTime()})
+	return ft, nil
+}
+
+// DetectContentType detects the type of content b, the leading bytes of file.
+//
+// It recognizes executables (ELF, PE, Mach-O), images, archives, audio and video, scripts with shebang and text encodings (BOM, UTF-8 and ASCII); otherwise it falls back to http.DetectContentType.
+func DetectContentType(b []byte) *FileType {
+	if len(b) == 0 {
+		return &FileType{"inode/x-empty", "empty"}
+	}
+	if bytes.HasPrefix(b, []byte("\x7fELF")) {
+		return elfType(b)
+	}
+	// 0xcafebabe is shared by Mach-O universal binary and Java class
+	if bytes.HasPrefix(b, []byte("\xca\xfe\xba\xbe")) && len(b) >= 8 {
+		if binary.BigEndian.Uint32(b[4:8]) < 45 {
+			return &FileType{"application/x-mach-binary", "Mach-O universal binary"}
+		}
+		return &FileType{"application/java-vm", "compiled Java class"}
+	}
+	if bytes.HasPrefix(b, []byte("RIFF")) && len(b) >= 12 {
+		if m, ok := riffTypes[string(b[8:12])]; ok {
+			return &FileType{m.mime, m.desc}
+		}
+	}
+	if bytes.HasPrefix(b, []byte("MZ")) {
+		if ft := peType(b); ft != nil {
+			return ft
+		}
+	}
+	if isBMP(b) {
+		return &FileType{"image/bmp", "BMP image"}
+	}
+	for _, m := range magicNumbers {
+		end := m.offset + len(m.sig)
+		if len(b) >= end && string(b[m.offset:end]) == m.sig {
+			return &FileType{m.mime, m.desc}
+		}
+	}
+	if bytes.HasPrefix(b, []byte("#!")) {
+		return scriptType(b)
+	}
+	if ft := bomType(b); ft != nil {
+		return ft
+	}
+	if isTextContent(b) {
+		// http.DetectContentType also matches the short signatures of binary (e.g. "BM"), so only text types are used
+		mime := http.DetectContentType(b)
+		if strings.HasPrefix(mime, "text/") && !strings.HasPrefix(mime, "text/plain") {
+			return &FileType{mime, strings.ToUpper(strings.TrimPrefix(strings.Split(mime, ";")[0], "text/")) + " document"}
+		}
+		if isASCII(b) {
+			return &FileType{"text/plain; charset=us-ascii", "ASCII text"}
+		}
+		return &FileType{"text/plain; charset=utf-8", "UTF-8 Unicode text"}
+	}
+	mime := http.DetectContentType(b)
+	if mime == "application/octet-stream" {
+		return &FileType{mime, "data"}
+	}
+	return &FileType{mime, mime}
+}
+
+func elfType(b []byte) *FileType {
+	class := "ELF"
+	if len(b) > 4 {
+		switch b[4] {
+		case 1:
+			class = "ELF 32-bit"
+		case 2:
+			class = "ELF 64-bit"
+		}
+	}
+	if len(b) < 18 {
+		return &FileType{"application/x-elf", class}
+	}
+	var order binary.ByteOrder = binary.LittleEndian
+	if b[5] == 2 {
+		order = binary.BigEndian
+	}
+	switch order.Uint16(b[16:18]) {
+	case 1:
+		return &FileType{"application/x-object", class + " relocatable"}
+	case 2:
+		return &FileType{"application/x-executable", class + " executable"}
+	case 3:
+		// position-independent executable has an interpreter
+		if elfHasInterp(b, order) {
+			return &FileType{"application/x-pie-executable", class + " pie executable"}
+		}
+		return &FileType{"application/x-sharedlib", class + " shared object"}
+	case 4:
+		return &FileType{"application/x-coredump", class + " core file"}
+	default:
+		return &FileType{"application/x-elf", class}
+	}
+}
+
+// elfHasInterp returns true if the program headers (in b) of ELF contain PT_INTERP
+func elfHasInterp(b []byte, order binary.ByteOrder) bool {
+	var phoff uint64
+	var phentsize, phnum uint16
+	switch {
+	case b[4] == 2 && len(b) >= 64:
+		phoff = order.Uint64(b[32:40])
+		phentsize = order.Uint16(b[54:56])
+		phnum = order.Uint16(b[56:58])
+	case b[4] == 1 && len(b) >= 52:
+		phoff = uint64(order.Uint32(b[28:32]))
+		phentsize = order.Uint16(b[42:44])
+		phnum = order.Uint16(b[44:46])
+	default:
+		return false
+	}
+	for i := uint64(0); i < uint64(phnum); i++ {
+		off := phoff + i*uint64(phentsize)
+		if off+4 > uint64(len(b)) {
+			break
+		}
+		if order.Uint32(b[off:off+4]) == 3 { // PT_INTERP
+			return true
+		}
+	}
+	return false
+}
+
+// peType returns the type of MS-DOS or PE executable beginning with "MZ": it is PE if e_lfanew (at 0x3c) points to "PE\0\0", or MS-DOS executable if the content is not text; otherwise nil.
+func peType(b []byte) *FileType {
+	if len(b) >= 0x40 {
+		lfanew := int(binary.LittleEndian.Uint32(b[0x3c:0x40]))
+		if lfanew >= 0x40 && lfanew+4 <= len(b) && string(b[lfanew:lfanew+4]) == "PE\x00\x00" {
+			return &FileType{"application/vnd.microsoft.portable-executable", "PE executable (MS Windows)"}
+		}
+	}
+	if len(b) >= 0x1c && !isTextContent(b) {
+		return &FileType{"application/x-dosexec", "MS-DOS executable"}
+	}
+	return nil
+}
+
+// bmpInfoSizes are the sizes of the information headers of BMP (BITMAPCOREHEADER to BITMAPV5HEADER)
+var bmpInfoSizes = map[uint32]bool{12: true, 16: true, 40: true, 52: true, 56: true, 64: true, 108: true, 124: true}
+
+// isBMP returns true if b begins with the headers of BMP: "BM", the reserved zeros and the size of information header
+func isBMP(b []byte) bool {
+	if len(b) < 18 || !bytes.HasPrefix(b, []byte("BM")) {
+		return false
+	}
+	if binary.LittleEndian.Uint32(b[6:10]) != 0 {
+		return false
+	}
+	return bmpInfoSizes[binary.LittleEndian.Uint32(b[14:18])]
+}
+
+func scriptType(b []byte) *FileType {
+	line := string(b[2:])
+	if i := strings.IndexByte(line, '\n'); i >= 0 {
+		line = line[:i]
+	}
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return &FileType{"text/x-script", "script text"}
+	}
+	interp := filepath.Base(fields[0])
+	if interp == "env" {
+		interp = ""
+		for _, f := range fields[1:] {
+			if !strings.HasPrefix(f, "-") {
+				interp = filepath.Base(f)
+				break
+			}
+		}
+	}
+	mime, ok := scriptTypes[interp]
+	if !ok {
+		// e.g. python3.9
+		mime, ok = scriptTypes[strings.TrimRight(interp, "0123456789.")]
+	}
+	if !ok {
+		mime = "text/x-script"
+	}
+	if len(interp) == 0 {
+		return &FileType{mime, "script text"}
+	}
+	return &FileType{mime, interp + " script text"}
+}
+
+func bomType(b []byte) *FileType {
+	switch {
+	case bytes.HasPrefix(b, []byte(BOM)):
+		return &FileType{"text/plain; charset=utf-8", "UTF-8 Unicode (with BOM) text"}
+	case bytes.HasPrefix(b, []byte("\xff\xfe\x00\x00")):
+		return &FileType{"text/plain; charset=utf-32le", "UTF-32 Unicode (little-endian) text"}
+	case bytes.HasPrefix(b, []byte("\x00\x00\xfe\xff")):
+		return &FileType{"text/plain; charset=utf-32be", "UTF-32 Unicode (big-endian) text"}
+	case bytes.HasPrefix(b, []byte("\xff\xfe")):
+		return &FileType{"text/plain; charset=utf-16le", "UTF-16 Unicode (little-endian) text"}
+	case bytes.HasPrefix(b, []byte("\xfe\xff")):
+		return &FileType{"text/plain; charset=utf-16be", "UTF-16 Unicode (big-endian) text"}
+	}
+	return nil
+}
+
+// isTextContent returns true if b is valid UTF-8 without control characters except white spaces; the incomplete rune at the end of b is ignored.
+func isTextContent(b []byte) bool {
+	for len(b) > 0 {
+		r, size := utf8.DecodeRune(b)
+		if r == utf8.RuneError && size <= 1 {
+			// b is truncated in the middle of a rune
+			return len(b) < utf8.UTFMax && !utf8.FullRune(b)
+		}
+		if r < 0x20 && r != '\t' && r != '\n' && r != '\r' && r != '\f' && r != '\v' && r != 0x1b {
+			return false
+		}
+		b = b[size:]
+	}
+	return true
+}
+
+func isASCII(b []byte) bool {
+	for _, c := range b {
+		if c >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
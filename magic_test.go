@@ -0,0 +1,95 @@
+package paw
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectContentType(t *testing.T) {
+	assert := assert.New(t)
+
+	pe := make([]byte, 0x100)
+	copy(pe, "MZ")
+	binary.LittleEndian.PutUint32(pe[0x3c:], 0x80)
+	copy(pe[0x80:], "PE\x00\x00")
+
+	dos := make([]byte, 0x40)
+	copy(dos, "MZ\x90\x00\x03")
+
+	bmp := make([]byte, 54)
+	copy(bmp, "BM")
+	binary.LittleEndian.PutUint32(bmp[2:], 54)
+	binary.LittleEndian.PutUint32(bmp[10:], 54)
+	binary.LittleEndian.PutUint32(bmp[14:], 40)
+
+	tests := []struct {
+		name    string
+		content []byte
+		mime    string
+	}{
+		{"empty", nil, "inode/x-empty"},
+		{"PE", pe, "application/vnd.microsoft.portable-executable"},
+		{"MS-DOS", dos, "application/x-dosexec"},
+		{"text of MZ", []byte("MZ is the initials of Mark Zbikowski.\n"), "text/plain; charset=us-ascii"},
+		{"BMP", bmp, "image/bmp"},
+		{"text of BM", []byte("BMW and BMX are not bitmaps.\n"), "text/plain; charset=us-ascii"},
+		{"PNG", []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\x0dIHDR"), "image/png"},
+		{"gzip", []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00"), "application/gzip"},
+		{"shell", []byte("#!/usr/bin/env bash\necho hi\n"), "text/x-shellscript"},
+		{"UTF-8", []byte("中文 text\n"), "text/plain; charset=utf-8"},
+	}
+	for _, tt := range tests {
+		assert.Equal(tt.mime, DetectContentType(tt.content).Mime, tt.name)
+	}
+}
+
+func TestCachedFileType(t *testing.T) {
+	assert := assert.New(t)
+
+	path := filepath.Join(t.TempDir(), "script")
+	tests := []struct {
+		content string
+		mime    string
+	}{
+		{"#!/bin/sh\necho hi\n", "text/x-shellscript"},
+		{"\x1f\x8b\x08\x00\x00\x00\x00\x00", "application/gzip"},
+	}
+	for _, tt := range tests {
+		assert.NoError(os.WriteFile(path, []byte(tt.content), 0644))
+		fi, err := os.Stat(path)
+		assert.NoError(err)
+		for i := 0; i < 2; i++ {
+			ft, err := cachedFileType(path, fi)
+			assert.NoError(err)
+			assert.Equal(tt.mime, ft.Mime)
+		}
+	}
+}
+
+func TestFileTypeCacheEviction(t *testing.T) {
+	assert := assert.New(t)
+
+	c := newFileTypeCache(2)
+	c.put(cachedType{path: "a"})
+	c.put(cachedType{path: "b"})
+	_, ok := c.get("a") // "b" becomes the least recently used
+	assert.True(ok)
+	c.put(cachedType{path: "c"})
+
+	for _, tt := range []struct {
+		path string
+		ok   bool
+	}{
+		{"a", true},
+		{"b", false},
+		{"c", true},
+	} {
+		_, ok := c.get(tt.path)
+		assert.Equal(tt.ok, ok, tt.path)
+	}
+	assert.Equal(2, c.order.Len())
+}
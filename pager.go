@@ -0,0 +1,306 @@
+package paw
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"unicode/utf8"
+)
+
+// PagingMode is the policy of paging output (like as `git --paginate`)
+type PagingMode int
+
+const (
+	// PagingAuto pages output only to terminal, and only if it exceeds the height of terminal
+	PagingAuto PagingMode = iota
+	// PagingAlways pages output to terminal
+	PagingAlways
+	// PagingNever never pages output
+	PagingNever
+)
+
+// PagingModeNames are the names of PagingMode
+var PagingModeNames = map[PagingMode]string{
+	PagingAuto:   "auto",
+	PagingAlways: "always",
+	PagingNever:  "never",
+}
+
+func (m PagingMode) String() string {
+	if name, ok := PagingModeNames[m]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParsePagingMode returns the PagingMode of `name`: auto, always or never
+func ParsePagingMode(name string) (PagingMode, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if len(name) == 0 {
+		return PagingAuto, nil
+	}
+	for m, n := range PagingModeNames {
+		if n == name {
+			return m, nil
+		}
+	}
+	return PagingAuto, fmt.Errorf("invalid paging mode %q, use auto, always or never", name)
+}
+
+// Pager is a writer paging the output to terminal out: the output is kept until it exceeds the height of terminal (see GetTerminalSize), and then it is piped to the pager command (see PagerCommand) as it is written, so that the streaming output is paged while it is produced; without the pager command, the output is shown by the built-in pager (see RunBuiltinPager) at Close. The output is written to out directly if it is short, or out is not a terminal.
+//
+// Pager reports the file descriptor of out (see IsTerminal), so that the colors are kept. Close must be called to flush the output and wait for the pager.
+type Pager struct {
+	out         *os.File
+	mode        PagingMode
+	height      int
+	buf         bytes.Buffer
+	nlines      int
+	cmd         *exec.Cmd
+	pipe        io.WriteCloser
+	isBuiltin   bool
+	passthrough bool
+}
+
+// NewPager returns the pager of `out` under mode m
+func NewPager(out *os.File, m PagingMode) *Pager {
+	p := &Pager{out: out, mode: m}
+	p.height, _ = GetTerminalSize()
+	if m == PagingNever || !IsTerminal(out) {
+		p.passthrough = true
+	}
+	return p
+}
+
+// Fd returns the file descriptor of the output of p
+func (p *Pager) Fd() uintptr {
+	return p.out.Fd()
+}
+
+func (p *Pager) Write(b []byte) (int, error) {
+	switch {
+	case p.passthrough:
+		return p.out.Write(b)
+	case p.pipe != nil:
+		return p.pipe.Write(b)
+	}
+	p.buf.Write(b)
+	if p.isBuiltin {
+		return len(b), nil
+	}
+	p.nlines += bytes.Count(b, []byte{'\n'})
+	if p.mode == PagingAlways || p.nlines >= p.height {
+		p.start()
+	}
+	return len(b), nil
+}
+
+// start starts the pager command and writes the kept output to it; it falls back to the built-in pager if there is no pager command, or writes to out directly if paging is disabled by PAGER.
+func (p *Pager) start() {
+	command, ok := PagerCommand()
+	switch {
+	case !ok:
+		p.passthrough = true
+		p.buf.WriteTo(p.out)
+		return
+	case len(command) == 0:
+		p.isBuiltin = true
+		return
+	}
+	cmd := exec.Command("sh", "-c", command)
+	cmd.Stdout = p.out
+	cmd.Stderr = os.Stderr
+	cmd.Env = os.Environ()
+	// like as git, let less keep colors and quit if one screen
+	if _, ok := os.LookupEnv("LESS"); !ok {
+		cmd.Env = append(cmd.Env, "LESS=FRX")
+	}
+	if _, ok := os.LookupEnv("LV"); !ok {
+		cmd.Env = append(cmd.Env, "LV=-c")
+	}
+	pipe, err := cmd.StdinPipe()
+	if err == nil {
+		err = cmd.Start()
+	}
+	if err != nil {
+		Logger.Warnf("pager %q: %v, use the built-in pager", command, err)
+		p.isBuiltin = true
+		return
+	}
+	p.cmd, p.pipe = cmd, pipe
+	p.buf.WriteTo(pipe)
+}
+
+// Close flushes the output and waits for the pager to quit
+func (p *Pager) Close() error {
+	switch {
+	case p.passthrough:
+		return nil
+	case p.pipe != nil:
+		p.pipe.Close()
+		return p.cmd.Wait()
+	case p.isBuiltin:
+		return RunBuiltinPager(p.out, p.buf.String())
+	}
+	_, err := p.buf.WriteTo(p.out)
+	return err
+}
+
+// PagerCommand returns the command of pager: PAGER, or "less" if it is found, or "" for the built-in pager; ok is false if paging is disabled by PAGER ("" or "cat").
+func PagerCommand() (command string, ok bool) {
+	if pager, isSet := os.LookupEnv("PAGER"); isSet {
+		pager = strings.TrimSpace(pager)
+		if len(pager) == 0 || pager == "cat" {
+			return "", false
+		}
+		return pager, true
+	}
+	if _, err := exec.LookPath("less"); err == nil {
+		return "less", true
+	}
+	return "", true
+}
+
+// RunBuiltinPager shows `text` page by page on terminal out, reading keys from /dev/tty like as `less -RS`: the colors are kept and the long lines are chopped. Keys: space, f or PgDn (next page), b or PgUp (previous page), j, Enter or ↓ (next line), k or ↑ (previous line), d and u (half page), g and G (top and bottom), q (quit). It writes text to out directly if the terminal can not be controlled.
+func RunBuiltinPager(out *os.File, text string) error {
+	tty, err := os.Open("/dev/tty")
+	if err != nil {
+		_, err = io.WriteString(out, text)
+		return err
+	}
+	defer tty.Close()
+	restore, err := sttyRaw(tty)
+	if err != nil {
+		_, err = io.WriteString(out, text)
+		return err
+	}
+	defer restore()
+
+	var (
+		lines         = strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+		height, width = GetTerminalSize()
+		page          = MaxInt(height-1, 1)
+		maxTop        = MaxInt(len(lines)-page, 0)
+		top           int
+		key           = make([]byte, 8)
+	)
+	// alternate screen
+	fmt.Fprint(out, "\x1b[?1049h")
+	defer fmt.Fprint(out, "\x1b[?1049l")
+	for {
+		sb := new(strings.Builder)
+		sb.WriteString("\x1b[H\x1b[2J")
+		for i := top; i < top+page && i < len(lines); i++ {
+			sb.WriteString(TruncateANSI(lines[i], width))
+			sb.WriteString("\x1b[0m\n")
+		}
+		end := MinInt(top+page, len(lines))
+		fmt.Fprintf(sb, "\x1b[7m lines %d-%d/%d (%d%%)  q: quit, space/b: page, j/k: line, g/G: top/bottom \x1b[0m",
+			top+1, end, len(lines), end*100/MaxInt(len(lines), 1))
+		fmt.Fprint(out, sb.String())
+
+		n, err := tty.Read(key)
+		if err != nil {
+			return nil
+		}
+		switch string(key[:n]) {
+		case "q", "Q", "\x1b", "\x03":
+			return nil
+		case " ", "f", "\x06", "\x1b[6~":
+			top += page
+		case "b", "\x02", "\x1b[5~":
+			top -= page
+		case "j", "e", "\r", "\n", "\x1b[B", "\x1bOB":
+			top++
+		case "k", "y", "\x1b[A", "\x1bOA":
+			top--
+		case "d", "\x04":
+			top += page / 2
+		case "u", "\x15":
+			top -= page / 2
+		case "g", "<", "\x1b[H":
+			top = 0
+		case "G", ">", "\x1b[F":
+			top = maxTop
+		}
+		top = MaxInt(MinInt(top, maxTop), 0)
+	}
+}
+
+// sttyRaw sets terminal tty to read keys one by one without echo (use `stty`), and returns the function restoring it
+func sttyRaw(tty *os.File) (restore func(), err error) {
+	cmd := exec.Command("stty", "-g")
+	cmd.Stdin = tty
+	saved, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+	cmd = exec.Command("stty", "-icanon", "-echo", "min", "1")
+	cmd.Stdin = tty
+	if err := cmd.Run(); err != nil {
+		return nil, err
+	}
+	return func() {
+		cmd := exec.Command("stty", strings.TrimSpace(string(saved)))
+		cmd.Stdin = tty
+		cmd.Run()
+	}, nil
+}
+
+// TruncateANSI truncates `s` to width w cells on terminal; the escape sequences of ANSI (e.g. colors) are kept and not counted.
+func TruncateANSI(s string, w int) string {
+	var (
+		sb    = new(strings.Builder)
+		width int
+	)
+	for i := 0; i < len(s); {
+		if s[i] == 0x1b {
+			j := ansiSequenceEnd(s, i)
+			sb.WriteString(s[i:j])
+			i = j
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		rw := RuneWidth(r)
+		if width+rw > w {
+			// skip the rest of text, but keep the escape sequences
+			i += size
+			continue
+		}
+		width += rw
+		sb.WriteString(s[i : i+size])
+		i += size
+	}
+	return sb.String()
+}
+
+// ansiSequenceEnd returns the end of the escape sequence of ANSI (CSI or OSC) beginning at s[i]
+func ansiSequenceEnd(s string, i int) int {
+	j := i + 1
+	if j >= len(s) {
+		return j
+	}
+	switch s[j] {
+	case '[':
+		for j++; j < len(s); j++ {
+			if s[j] >= 0x40 && s[j] <= 0x7e {
+				return j + 1
+			}
+		}
+	case ']':
+		for j++; j < len(s); j++ {
+			if s[j] == 0x07 {
+				return j + 1
+			}
+			if s[j] == 0x1b && j+1 < len(s) && s[j+1] == '\\' {
+				return j + 2
+			}
+		}
+	default:
+		return j + 1
+	}
+	return len(s)
+}
@@ -0,0 +1,228 @@
+package paw
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shyang107/paw/cast"
+)
+
+// xterm16 is the default palette of xterm for the 16 standard and high-intensity colors
+var xterm16 = [16][3]uint8{
+	{0x00, 0x00, 0x00}, {0xcd, 0x00, 0x00}, {0x00, 0xcd, 0x00}, {0xcd, 0xcd, 0x00},
+	{0x00, 0x00, 0xee}, {0xcd, 0x00, 0xcd}, {0x00, 0xcd, 0xcd}, {0xe5, 0xe5, 0xe5},
+	{0x7f, 0x7f, 0x7f}, {0xff, 0x00, 0x00}, {0x00, 0xff, 0x00}, {0xff, 0xff, 0x00},
+	{0x5c, 0x5c, 0xff}, {0xff, 0x00, 0xff}, {0x00, 0xff, 0xff}, {0xff, 0xff, 0xff},
+}
+
+// cubeLevels are the intensities of 6 × 6 × 6 color cube (16-231)
+var cubeLevels = [6]uint8{0x00, 0x5f, 0x87, 0xaf, 0xd7, 0xff}
+
+// Color256RGB returns the RGB of 256-color `code` using the palette of xterm: 0-15 are the standard and high-intensity colors, 16-231 are the 6 × 6 × 6 cube and 232-255 are the 24 grays.
+func Color256RGB(code int) (r, g, b uint8) {
+	switch {
+	case code < 0:
+		code = 0
+	case code > 255:
+		code = 255
+	}
+	switch {
+	case code < 16:
+		c := xterm16[code]
+		return c[0], c[1], c[2]
+	case code < 232:
+		i := code - 16
+		return cubeLevels[i/36], cubeLevels[i/6%6], cubeLevels[i%6]
+	default:
+		v := uint8(8 + 10*(code-232))
+		return v, v, v
+	}
+}
+
+// Color256Hex returns the RGB of 256-color `code` as "#rrggbb" (see Color256RGB)
+func Color256Hex(code int) string {
+	r, g, b := Color256RGB(code)
+	return fmt.Sprintf("#%02x%02x%02x", r, g, b)
+}
+
+// ColorAttributes returns the SGR attributes of color `c`, e.g. [38 5 33 1], regardless of `NoColor`
+func ColorAttributes(c *Color) []Attribute {
+	if c == nil {
+		return nil
+	}
+	cc := CloneColor(c)
+	cc.EnableColor()
+	return ParseSGR(cc.Sprint(""))
+}
+
+// ParseSGR returns the attributes of the first SGR (Select Graphic Rendition) sequence, "ESC[...m", of `s`
+func ParseSGR(s string) []Attribute {
+	i := strings.Index(s, "\x1b[")
+	if i < 0 {
+		return nil
+	}
+	s = s[i+2:]
+	j := strings.IndexByte(s, 'm')
+	if j < 0 {
+		return nil
+	}
+	return SGRAttributes(s[:j])
+}
+
+// SGRAttributes returns the attributes of SGR parameters `params`, e.g. "38;5;33;1"; an empty parameter is 0 (reset).
+func SGRAttributes(params string) []Attribute {
+	as := []Attribute{}
+	for _, p := range strings.Split(params, ";") {
+		as = append(as, Attribute(cast.ToInt(p)))
+	}
+	return as
+}
+
+// TextStyle is the style of text set by SGR attributes of ANSI escape codes
+type TextStyle struct {
+	// Fg and Bg are the foreground and background colors as "#rrggbb", or "" for default
+	Fg, Bg     string
+	Bold       bool
+	Faint      bool
+	Italic     bool
+	Underline  bool
+	Blink      bool
+	Reverse    bool
+	CrossedOut bool
+}
+
+// NewTextStyle returns the TextStyle of SGR attributes `attrs`
+func NewTextStyle(attrs ...Attribute) TextStyle {
+	var s TextStyle
+	s.Apply(attrs...)
+	return s
+}
+
+// Apply applies SGR attributes `attrs` to s, including 256-color ("38;5;n") and truecolor ("38;2;r;g;b")
+func (s *TextStyle) Apply(attrs ...Attribute) {
+	for i := 0; i < len(attrs); i++ {
+		switch a := int(attrs[i]); {
+		case a == 0:
+			*s = TextStyle{}
+		case a == 1:
+			s.Bold = true
+		case a == 2:
+			s.Faint = true
+		case a == 3:
+			s.Italic = true
+		case a == 4:
+			s.Underline = true
+		case a == 5, a == 6:
+			s.Blink = true
+		case a == 7:
+			s.Reverse = true
+		case a == 9:
+			s.CrossedOut = true
+		case a == 22:
+			s.Bold, s.Faint = false, false
+		case a == 23:
+			s.Italic = false
+		case a == 24:
+			s.Underline = false
+		case a == 25:
+			s.Blink = false
+		case a == 27:
+			s.Reverse = false
+		case a == 29:
+			s.CrossedOut = false
+		case a >= 30 && a <= 37:
+			s.Fg = Color256Hex(a - 30)
+		case a == 38:
+			hex, n := extendedColor(attrs[i+1:])
+			s.Fg = hex
+			i += n
+		case a == 39:
+			s.Fg = ""
+		case a >= 40 && a <= 47:
+			s.Bg = Color256Hex(a - 40)
+		case a == 48:
+			hex, n := extendedColor(attrs[i+1:])
+			s.Bg = hex
+			i += n
+		case a == 49:
+			s.Bg = ""
+		case a >= 90 && a <= 97:
+			s.Fg = Color256Hex(a - 90 + 8)
+		case a >= 100 && a <= 107:
+			s.Bg = Color256Hex(a - 100 + 8)
+		}
+	}
+}
+
+// extendedColor returns the color of attributes following 38 or 48 ("5;n" or "2;r;g;b") and the number of them
+func extendedColor(attrs []Attribute) (hex string, n int) {
+	switch {
+	case len(attrs) >= 2 && attrs[0] == 5:
+		return Color256Hex(int(attrs[1])), 2
+	case len(attrs) >= 4 && attrs[0] == 2:
+		rgb := [3]int{int(attrs[1]), int(attrs[2]), int(attrs[3])}
+		for i, v := range rgb {
+			rgb[i] = MinInt(MaxInt(v, 0), 255)
+		}
+		return fmt.Sprintf("#%02x%02x%02x", rgb[0], rgb[1], rgb[2]), 4
+	default:
+		// malformed, ignore the rest
+		return "", len(attrs)
+	}
+}
+
+// IsZero returns true if s is the default style
+func (s TextStyle) IsZero() bool {
+	return s == TextStyle{}
+}
+
+// Colors returns the foreground and background colors of s, using `fg` and `bg` as default colors and swapping them if s is reversed.
+func (s TextStyle) Colors(fg, bg string) (string, string) {
+	if len(s.Fg) > 0 {
+		fg = s.Fg
+	}
+	if len(s.Bg) > 0 {
+		bg = s.Bg
+	}
+	if s.Reverse {
+		return bg, fg
+	}
+	return fg, bg
+}
+
+// CSS returns the CSS declarations of s, e.g. "color:#0087ff;font-weight:bold"; the default colors are `var(--fg)` and `var(--bg)`.
+func (s TextStyle) CSS() string {
+	var (
+		decls  = []string{}
+		decors = []string{}
+	)
+	fg, bg := s.Colors("", "")
+	if s.Reverse {
+		fg, bg = s.Colors("var(--fg)", "var(--bg)")
+	}
+	if len(fg) > 0 {
+		decls = append(decls, "color:"+fg)
+	}
+	if len(bg) > 0 {
+		decls = append(decls, "background-color:"+bg)
+	}
+	if s.Bold {
+		decls = append(decls, "font-weight:bold")
+	}
+	if s.Faint {
+		decls = append(decls, "opacity:0.7")
+	}
+	if s.Italic {
+		decls = append(decls, "font-style:italic")
+	}
+	if s.Underline {
+		decors = append(decors, "underline")
+	}
+	if s.CrossedOut {
+		decors = append(decors, "line-through")
+	}
+	if len(decors) > 0 {
+		decls = append(decls, "text-decoration:"+strings.Join(decors, " "))
+	}
+	return strings.Join(decls, ";")
+}
@@ -0,0 +1,204 @@
+package paw
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/shyang107/paw/runewidth"
+)
+
+// SVGOption is the options of FprintANSISVG
+type SVGOption struct {
+	// Title is the title of SVG document
+	Title string
+	// FontFamily is the font family of text, which should be monospace
+	FontFamily string
+	// FontSize is the size of font in pixels; the width of a column is 0.6 × FontSize and the height of a line is 1.2 × FontSize.
+	FontSize float64
+	// Fg and Bg are the default foreground and background colors as "#rrggbb"
+	Fg, Bg string
+	// Padding is the padding around the text in pixels
+	Padding float64
+	// TabWidth is the number of columns between tab stops
+	TabWidth int
+}
+
+// DefaultSVGOption is the default SVGOption of FprintANSISVG
+var DefaultSVGOption = SVGOption{
+	FontFamily: "SFMono-Regular,Menlo,Consolas,'DejaVu Sans Mono',monospace",
+	FontSize:   14,
+	Fg:         "#d0d0d0",
+	Bg:         "#1c1c1c",
+	Padding:    12,
+	TabWidth:   8,
+}
+
+// ansiRun is a run of text of a line with the same style, starting at column col and taking width columns
+type ansiRun struct {
+	col, width int
+	text       string
+	style      TextStyle
+}
+
+// ansiRuns splits `line` into runs of the same style; `style` is the style at the start of line and is updated by the SGR sequences of line. Other escape sequences and control characters are ignored.
+func ansiRuns(line string, style *TextStyle, tabWidth int) (runs []ansiRun, width int) {
+	var (
+		cur ansiRun
+		b   strings.Builder
+	)
+	flush := func() {
+		if b.Len() > 0 {
+			cur.text = b.String()
+			runs = append(runs, cur)
+			b.Reset()
+		}
+		cur = ansiRun{col: width, style: *style}
+	}
+	flush()
+	for i := 0; i < len(line); {
+		if line[i] == '\x1b' && i+1 < len(line) {
+			switch line[i+1] {
+			case '[': // CSI: parameters and the final byte in 0x40-0x7e
+				j := i + 2
+				for j < len(line) && (line[j] < 0x40 || line[j] > 0x7e) {
+					j++
+				}
+				if j < len(line) && line[j] == 'm' {
+					flush()
+					style.Apply(SGRAttributes(line[i+2 : j])...)
+					cur.style = *style
+				}
+				i = j + 1
+			case ']': // OSC: terminated by BEL or ST
+				j := i + 2
+				for j < len(line) && line[j] != '\a' && !(line[j] == '\x1b' && j+1 < len(line) && line[j+1] == '\\') {
+					j++
+				}
+				if j < len(line) && line[j] == '\x1b' {
+					j++
+				}
+				i = j + 1
+			default:
+				i += 2
+			}
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(line[i:])
+		i += size
+		switch {
+		case r == '\t':
+			n := tabWidth - width%tabWidth
+			b.WriteString(strings.Repeat(" ", n))
+			cur.width += n
+			width += n
+		case r < 0x20 || r == 0x7f:
+			continue
+		default:
+			n := runewidth.RuneWidth(r)
+			b.WriteRune(r)
+			cur.width += n
+			width += n
+		}
+	}
+	flush()
+	return runs, width
+}
+
+// FprintANSISVG writes the standalone SVG document reproducing `text` with ANSI escape codes (16, 256 and true colors, bold, faint, italic, underline, reverse and crossed-out) to w. Text is laid out in a grid of columns, and each run of text is fitted to its columns counted by `runewidth`, so wide characters keep aligned. If opt is nil, DefaultSVGOption is used.
+func FprintANSISVG(w io.Writer, text string, opt *SVGOption) error {
+	if opt == nil {
+		opt = &DefaultSVGOption
+	}
+	tabWidth := opt.TabWidth
+	if tabWidth < 1 {
+		tabWidth = 8
+	}
+	var (
+		lines    = strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+		cw       = 0.6 * opt.FontSize
+		lh       = 1.2 * opt.FontSize
+		style    TextStyle
+		ncols    int
+		bgs      strings.Builder
+		texts    strings.Builder
+		svgFloat = func(f float64) string {
+			return strconv.FormatFloat(math.Round(f*100)/100, 'f', -1, 64)
+		}
+	)
+	for i, line := range lines {
+		runs, width := ansiRuns(line, &style, tabWidth)
+		ncols = MaxInt(ncols, width)
+		y := opt.Padding + float64(i)*lh
+		texts.WriteString(fmt.Sprintf("<text y=\"%s\">", svgFloat(y+0.95*opt.FontSize)))
+		for _, run := range runs {
+			fg, bg := run.style.Colors(opt.Fg, opt.Bg)
+			x := opt.Padding + float64(run.col)*cw
+			if bg != opt.Bg {
+				bgs.WriteString(fmt.Sprintf("<rect x=\"%s\" y=\"%s\" width=\"%s\" height=\"%s\" fill=\"%s\"/>\n",
+					svgFloat(x), svgFloat(y), svgFloat(float64(run.width)*cw), svgFloat(lh), bg))
+			}
+			if len(strings.TrimSpace(run.text)) == 0 && !run.style.Underline && !run.style.CrossedOut {
+				continue
+			}
+			texts.WriteString(fmt.Sprintf("<tspan x=\"%s\" textLength=\"%s\" lengthAdjust=\"spacingAndGlyphs\"",
+				svgFloat(x), svgFloat(float64(run.width)*cw)))
+			if fg != opt.Fg {
+				texts.WriteString(" fill=\"" + fg + "\"")
+			}
+			texts.WriteString(svgTextAttributes(run.style))
+			texts.WriteString(">" + html.EscapeString(run.text) + "</tspan>")
+		}
+		texts.WriteString("</text>\n")
+	}
+
+	var (
+		b      strings.Builder
+		width  = svgFloat(2*opt.Padding + float64(ncols)*cw)
+		height = svgFloat(2*opt.Padding + float64(len(lines))*lh)
+	)
+	b.WriteString(fmt.Sprintf("<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%s\" height=\"%s\" viewBox=\"0 0 %s %s\">\n",
+		width, height, width, height))
+	if len(opt.Title) > 0 {
+		b.WriteString("<title>" + html.EscapeString(opt.Title) + "</title>\n")
+	}
+	b.WriteString(fmt.Sprintf("<rect width=\"100%%\" height=\"100%%\" fill=\"%s\"/>\n", opt.Bg))
+	b.WriteString(bgs.String())
+	b.WriteString(fmt.Sprintf("<g font-family=\"%s\" font-size=\"%s\" fill=\"%s\" xml:space=\"preserve\">\n",
+		html.EscapeString(opt.FontFamily), svgFloat(opt.FontSize), opt.Fg))
+	b.WriteString(texts.String())
+	b.WriteString("</g>\n</svg>\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// svgTextAttributes returns the SVG attributes of the font style and decorations of s with a leading space
+func svgTextAttributes(s TextStyle) string {
+	var (
+		attrs  = []string{""}
+		decors = []string{}
+	)
+	if s.Bold {
+		attrs = append(attrs, "font-weight=\"bold\"")
+	}
+	if s.Faint {
+		attrs = append(attrs, "fill-opacity=\"0.7\"")
+	}
+	if s.Italic {
+		attrs = append(attrs, "font-style=\"italic\"")
+	}
+	if s.Underline {
+		decors = append(decors, "underline")
+	}
+	if s.CrossedOut {
+		decors = append(decors, "line-through")
+	}
+	if len(decors) > 0 {
+		attrs = append(attrs, "text-decoration=\""+strings.Join(decors, " ")+"\"")
+	}
+	return strings.Join(attrs, " ")
+}
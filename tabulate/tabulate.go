@@ -21,6 +21,8 @@ type TableFormat struct {
 	Padding         int
 	HeaderHide      bool
 	FitScreen       bool
+	// NoEmptyLines is true if the lines not defined (e.g. LineTop of "github") are not printed
+	NoEmptyLines bool
 }
 
 // Represents a Line
@@ -67,9 +69,18 @@ var TableFormats = map[string]TableFormat{
 		TitleRow:        Row{"|", " ", "|"},
 		Padding:         1,
 	},
+	// GitHub-flavored Markdown table
+	"github": {
+		LineBelowHeader: Line{"|", "-", "|", "|"},
+		HeaderRow:       Row{"|", "|", "|"},
+		DataRow:         Row{"|", "|", "|"},
+		TitleRow:        Row{"|", " ", "|"},
+		Padding:         1,
+		NoEmptyLines:    true,
+	},
 }
 
-// Minimum padding that will be applied
+// Minimum padding that will be applied, the default of Tabulate.MinPadding
 var MIN_PADDING = 5
 
 // Main Tabulate structure
@@ -88,6 +99,7 @@ type Tabulate struct {
 	WrapDelimiter rune
 	SplitConcat   string
 	DenseMode     bool
+	MinPadding    int // minimum padding of cells, MIN_PADDING by default
 	//
 	// AlignA []string
 	Widths   []int
@@ -167,7 +179,7 @@ func (t *Tabulate) buildLine(padded_widths []int, padding []int, l Line) string
 
 	for i := range cells {
 		b := createBuffer()
-		b.Write(l.hline, padding[i]+MIN_PADDING)
+		b.Write(l.hline, padding[i]+t.MinPadding)
 		cells[i] = b.String()
 	}
 
@@ -267,7 +279,7 @@ func (t *Tabulate) Render(format ...interface{}) string {
 
 	padded_widths := make([]int, len(cols))
 	for i := range padded_widths {
-		padded_widths[i] = cols[i] + MIN_PADDING*t.TableFormat.Padding
+		padded_widths[i] = cols[i] + t.MinPadding*t.TableFormat.Padding
 	}
 
 	// Calculate total width of the table
@@ -278,7 +290,7 @@ func (t *Tabulate) Render(format ...interface{}) string {
 
 	// Start appending lines
 	if len(t.Title) > 0 {
-		if !inSlice("aboveTitle", t.HideLines) {
+		if !inSlice("aboveTitle", t.HideLines) && t.hasLine(t.TableFormat.LineTop) {
 			lines = append(lines, t.buildLine(padded_widths, cols, t.TableFormat.LineTop))
 		}
 		savedAlign := t.Align
@@ -290,7 +302,7 @@ func (t *Tabulate) Render(format ...interface{}) string {
 	}
 
 	// Append top line if not hidden
-	if !inSlice("top", t.HideLines) {
+	if !inSlice("top", t.HideLines) && t.hasLine(t.TableFormat.LineTop) {
 		lines = append(lines, t.buildLine(padded_widths, cols, t.TableFormat.LineTop))
 	}
 
@@ -298,7 +310,7 @@ func (t *Tabulate) Render(format ...interface{}) string {
 	lines = append(lines, t.buildRow(t.padRow(t.Headers, t.TableFormat.Padding), padded_widths, cols, t.TableFormat.HeaderRow))
 
 	// Add Line Below Header if not hidden
-	if !inSlice("belowheader", t.HideLines) {
+	if !inSlice("belowheader", t.HideLines) && t.hasLine(t.TableFormat.LineBelowHeader) {
 		lines = append(lines, t.buildLine(padded_widths, cols, t.TableFormat.LineBelowHeader))
 	}
 
@@ -306,13 +318,13 @@ func (t *Tabulate) Render(format ...interface{}) string {
 	for index, element := range t.Data {
 		lines = append(lines, t.buildRow(t.padRow(element.Elements, t.TableFormat.Padding), padded_widths, cols, t.TableFormat.DataRow))
 		if !t.DenseMode && index < len(t.Data)-1 {
-			if element.Continuos != true && !inSlice("betweenLine", t.HideLines) {
+			if element.Continuos != true && !inSlice("betweenLine", t.HideLines) && t.hasLine(t.TableFormat.LineBetweenRows) {
 				lines = append(lines, t.buildLine(padded_widths, cols, t.TableFormat.LineBetweenRows))
 			}
 		}
 	}
 
-	if !inSlice("bottomLine", t.HideLines) {
+	if !inSlice("bottomLine", t.HideLines) && t.hasLine(t.TableFormat.LineBottom) {
 		lines = append(lines, t.buildLine(padded_widths, cols, t.TableFormat.LineBottom))
 	}
 
@@ -325,6 +337,11 @@ func (t *Tabulate) Render(format ...interface{}) string {
 	return buffer.String()
 }
 
+// hasLine returns false if l is not defined and TableFormat.NoEmptyLines is true
+func (t *Tabulate) hasLine(l Line) bool {
+	return !t.TableFormat.NoEmptyLines || l != (Line{})
+}
+
 // Calculate the max column width for each element
 func (t *Tabulate) getWidths(headers []string, data []*TabulateRow) []int {
 	widths := make([]int, len(headers))
@@ -421,6 +438,11 @@ func (t *Tabulate) SetDenseMode() {
 	t.DenseMode = true
 }
 
+// SetMinPadding sets the minimum padding of cells of this table only
+func (t *Tabulate) SetMinPadding(padding int) {
+	t.MinPadding = padding
+}
+
 // func (t *Tabulate) SetAlignA(aligns []string) {
 // 	t.AlignA = aligns
 // }
@@ -521,7 +543,7 @@ func (t *Tabulate) wrapCellData() []*TabulateRow {
 // 2D Bool Array, 2D Float64 Array, 2D interface{} Array,
 // Map map[strig]string, Map map[string]interface{},
 func Create(data interface{}) *Tabulate {
-	t := &Tabulate{FloatFormat: 'f', MaxSize: 30}
+	t := &Tabulate{FloatFormat: 'f', MaxSize: 30, MinPadding: MIN_PADDING}
 
 	switch v := data.(type) {
 	case [][]string:
@@ -182,14 +182,79 @@ func IsEqualString(a, b string, ignoreCase bool) bool {
 	return false
 }
 
+// CompareNatural compares string `a` and `b` in natural (version) order, i.e. runs of digits are compared by their numeric values, so that "file2" < "file10" and "v1.9" < "v1.10". It returns -1, 0 or +1.
+func CompareNatural(a, b string) int {
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		ca, cb := a[i], b[j]
+		if !isDigit(ca) || !isDigit(cb) {
+			if ca != cb {
+				if ca < cb {
+					return -1
+				}
+				return 1
+			}
+			i++
+			j++
+			continue
+		}
+		// runs of digits
+		si, sj := i, j
+		for i < len(a) && isDigit(a[i]) {
+			i++
+		}
+		for j < len(b) && isDigit(b[j]) {
+			j++
+		}
+		na, nb := strings.TrimLeft(a[si:i], "0"), strings.TrimLeft(b[sj:j], "0")
+		if len(na) != len(nb) {
+			if len(na) < len(nb) {
+				return -1
+			}
+			return 1
+		}
+		if c := strings.Compare(na, nb); c != 0 {
+			return c
+		}
+		// the same value, fewer leading zeros first
+		if za, zb := i-si, j-sj; za != zb {
+			if za < zb {
+				return -1
+			}
+			return 1
+		}
+	}
+	switch {
+	case len(a)-i < len(b)-j:
+		return -1
+	case len(a)-i > len(b)-j:
+		return 1
+	}
+	return 0
+}
+
+// NaturalLess reports whether `a` is less than `b` in natural (version) order, see CompareNatural
+func NaturalLess(a, b string) bool {
+	return CompareNatural(a, b) < 0
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// BOM is the byte order mark of UTF-8
+const BOM = "\xef\xbb\xbf"
+
+// HasBOM returns true if `line` begins with the BOM character of UTF-8
+func HasBOM(line string) bool {
+	return strings.HasPrefix(line, BOM)
+}
+
 // TrimBOM trim the leading BOM character of a string
 func TrimBOM(line string) string {
-	l := len(line)
-	if l >= 3 {
-		if line[0] == 0xef && line[1] == 0xbb && line[2] == 0xbf {
-			trimLine := line[3:]
-			return trimLine
-		}
+	if HasBOM(line) {
+		trimLine := line[len(BOM):]
+		return trimLine
 	}
 	return line
 }
@@ -0,0 +1,35 @@
+package paw
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareNatural(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"a", "a", 0},
+		{"a", "b", -1},
+		{"b", "a", 1},
+		{"a", "ab", -1},
+		{"file2", "file10", -1},
+		{"file10", "file2", 1},
+		{"v1.9", "v1.10", -1},
+		{"v1.10.1", "v1.10", 1},
+		{"a01", "a1", 1},
+		{"a1", "a01", -1},
+		{"a007", "a7b", 1},
+		{"x2y10", "x2y9", 1},
+		{"10", "9a", 1},
+	}
+	for _, tt := range tests {
+		assert.Equal(tt.want, CompareNatural(tt.a, tt.b), "CompareNatural(%q, %q)", tt.a, tt.b)
+		assert.Equal(tt.want < 0, NaturalLess(tt.a, tt.b), "NaturalLess(%q, %q)", tt.a, tt.b)
+	}
+}
@@ -0,0 +1,474 @@
+package paw
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// Theme is a set of colors overriding the default colors (EXAColorAttributes and LSColorAttributes).
+//
+// The keys of sections are the keys of EXAColorAttributes (e.g. "in", "ur", "sn", "ga"), the keys of exa (see ThemeKeyAliases, e.g. "sb", "bl") or the readable names (e.g. "inode", "user-read", "kilo", "modified"). A color is a spec of ParseColorSpec, e.g. "bold 209", "underline 8 on 236" or "38;5;156;1".
+type Theme struct {
+	// Name is the name of theme
+	Name string `yaml:"name" toml:"name"`
+	// Base is the name of built-in theme applied before this theme
+	Base string `yaml:"base" toml:"base"`
+	// Reset clears all the default colors before applying this theme, so that the items without color in theme are plain
+	Reset bool `yaml:"reset" toml:"reset"`
+	// Fields are the colors of fields, e.g. inode, links, user, date, header
+	Fields map[string]string `yaml:"fields" toml:"fields"`
+	// Permissions are the colors of permission bits, e.g. ur, uw, ux
+	Permissions map[string]string `yaml:"permissions" toml:"permissions"`
+	// Sizes are the colors of size: number and unit, and those of ranges (bytes, kilo, mega, giga and tera)
+	Sizes map[string]string `yaml:"sizes" toml:"sizes"`
+	// Git are the colors of git status: new, modified, deleted, renamed and typechange
+	Git map[string]string `yaml:"git" toml:"git"`
+	// Files are the colors of names by the kinds of LS_COLORS (e.g. di, ln, ex), extensions (e.g. "*.go" or ".go") or names (e.g. "*Makefile")
+	Files map[string]string `yaml:"files" toml:"files"`
+}
+
+// ThemeKeyAliases maps the readable names and the keys of exa (EXA_COLORS) to the keys of EXAColorAttributes
+var ThemeKeyAliases = map[string]string{
+	// fields
+	"inode":        "in",
+	"links":        "lk",
+	"blocks":       "bk",
+	"user":         "uu",
+	"user-other":   "un",
+	"group":        "gu",
+	"group-other":  "gn",
+	"date":         "da",
+	"header":       "hd",
+	"capability":   "cap",
+	"context":      "ctx",
+	"filetype":     "ftype",
+	"encoding":     "enc",
+	"xattr-symbol": "xsymb",
+	"dir-path":     "dir",
+	"punctuation":  "-",
+	"prompt":       "pmpt",
+	// permissions
+	"user-read":   "ur",
+	"user-write":  "uw",
+	"user-exec":   "ux",
+	"group-read":  "gr",
+	"group-write": "gw",
+	"group-exec":  "gx",
+	"other-read":  "tr",
+	"other-write": "tw",
+	"other-exec":  "tx",
+	"setuid":      "su",
+	"setgid":      "sg",
+	"sticky":      "st",
+	// sizes
+	"number":     "sn",
+	"unit":       "snu",
+	"bytes":      "nb",
+	"kilo":       "nk",
+	"mega":       "nm",
+	"giga":       "ng",
+	"tera":       "nt",
+	"unit-bytes": "ub",
+	"unit-kilo":  "uk",
+	"unit-mega":  "um",
+	"unit-giga":  "ug",
+	"unit-tera":  "ut",
+	// git
+	"new":        "ga",
+	"modified":   "gm",
+	"deleted":    "gd",
+	"renamed":    "gv",
+	"typechange": "gt",
+	// exa
+	"sb": "snu",
+	"lc": "lk",
+	"bl": "bk",
+	"xx": "-",
+	"xa": "xsymb",
+}
+
+// exaFileKinds are the keys of kinds of file shared by EXAColorAttributes and LSColorAttributes
+var exaFileKinds = map[string]bool{
+	"di": true, "ex": true, "fi": true, "ln": true, "pi": true,
+	"so": true, "bd": true, "cd": true, "or": true,
+}
+
+var (
+	// defaultEXAColorAttributes and defaultLSColorAttributes are the default colors restored by ApplyTheme
+	defaultEXAColorAttributes = cloneColorAttributes(EXAColorAttributes)
+	defaultLSColorAttributes  = cloneColorAttributes(LSColorAttributes)
+	defaultReExtLSColors      = ReExtLSColors
+)
+
+// reloadColors are the default colors and their keys, which are reloaded in place by ReloadColors; keys prefixed with "ls:" are of LSColorAttributes.
+var reloadColors = map[*Color]string{
+	Chdp: "hd", Cdirp: "dir", Cdip: "di", Cfip: "fi", CNop: "-", Cinp: "in",
+	Cpms: "uw", Csnp: "sn", Csup: "snu", Cuup: "uu", Cgup: "gu", Cunp: "un",
+	Cgnp: "gn", Clkp: "lk", Cbkp: "bk", Cdap: "da", Cgitp: "gm", Cmd5p: "md5",
+	Caclp: "acl", Ccapp: "cap", Cctxp: "ctx", Cmimep: "mime", Cftypep: "ftype",
+	Clinep: "lines", Cwordp: "words", Cencp: "enc", Cxap: "xattr", Cxbp: "xsymb",
+	Cdashp: "-", Cnop: "no", Cbdp: "ls:bd", Ccdp: "ls:cd", Cpip: "ls:pi",
+	Csop: "ls:so", Clnp: "ln", Cexp: "ls:ex", Corp: "ls:or",
+	Cpmpt: "pmpt", CpmptSn: "pmptsn", CpmptSu: "pmptsu", CpmptDashp: "pmptdash",
+	Ctrace: "trace", Cdebug: "debug", Cinfo: "info", Cwarn: "warn", Cerror: "error",
+	Cfatal: "fatal", Cpanic: "panic", Cfield: "field", Cvalue: "value",
+	CEvenH: "evenH", COddH: "oddH", CEven: "even", COdd: "odd",
+	tbCxattr: "xattr", tbCxsymb: "xsymb",
+}
+
+func cloneColorAttributes(m map[string][]Attribute) map[string][]Attribute {
+	c := make(map[string][]Attribute, len(m))
+	for k, v := range m {
+		c[k] = append([]Attribute{}, v...)
+	}
+	return c
+}
+
+// resetColorAttributes restores EXAColorAttributes and LSColorAttributes to the defaults in place
+func resetColorAttributes() {
+	clearColorAttributes()
+	for k, v := range defaultEXAColorAttributes {
+		EXAColorAttributes[k] = append([]Attribute{}, v...)
+	}
+	for k, v := range defaultLSColorAttributes {
+		LSColorAttributes[k] = append([]Attribute{}, v...)
+	}
+	ReExtLSColors = defaultReExtLSColors
+}
+
+// clearColorAttributes removes all colors of EXAColorAttributes, LSColorAttributes, LSColorPatterns and ReExtLSColors
+func clearColorAttributes() {
+	LSColorPatterns = []LSColorPattern{}
+	LSColorLinkTarget = false
+	ReExtLSColors = map[*regexp.Regexp][]Attribute{}
+	for k := range EXAColorAttributes {
+		delete(EXAColorAttributes, k)
+	}
+	for k := range LSColorAttributes {
+		delete(LSColorAttributes, k)
+	}
+}
+
+// ReloadColors reloads the default colors (e.g. Cdip, Csnp) from EXAColorAttributes and LSColorAttributes in place, so that the colors referenced elsewhere follow the changes.
+func ReloadColors() {
+	for c, key := range reloadColors {
+		if strings.HasPrefix(key, "ls:") {
+			*c = *NewLSColor(strings.TrimPrefix(key, "ls:"))
+		} else {
+			*c = *NewEXAColor(key)
+		}
+	}
+}
+
+// setColorAttribute sets attrs to key: globs (e.g. "*.go") are set to LSColorPatterns, the kinds of file are set to both LSColorAttributes and EXAColorAttributes, others are set to EXAColorAttributes (see ThemeKeyAliases) or LSColorAttributes if isFile.
+func setColorAttribute(key string, attrs []Attribute, isFile bool) {
+	switch {
+	case strings.HasPrefix(key, "*"):
+		SetLSColorPattern(key, attrs)
+	case exaFileKinds[key]:
+		LSColorAttributes[key] = attrs
+		EXAColorAttributes[key] = attrs
+	case isFile:
+		LSColorAttributes[key] = attrs
+	default:
+		if k, ok := ThemeKeyAliases[key]; ok {
+			key = k
+		}
+		EXAColorAttributes[key] = attrs
+	}
+}
+
+// GetEXAColors applies EXA_COLORS from env of os, the same format as LS_COLORS (e.g. "di=1;34:sn=32:*.go=38;5;81"), see `man exa_colors`; "reset" clears all the default colors.
+func GetEXAColors() {
+	colorenv := os.Getenv("EXA_COLORS")
+	if len(colorenv) == 0 {
+		return
+	}
+	for _, a := range strings.Split(colorenv, ":") {
+		if a == "reset" {
+			clearColorAttributes()
+			continue
+		}
+		kv := strings.SplitN(a, "=", 2)
+		if len(kv) == 2 && len(kv[0]) > 0 {
+			setColorAttribute(kv[0], getLSColorAttribute(kv[1]), false)
+		}
+	}
+	ReloadColors()
+}
+
+// SizeUnitColors returns the colors of number and unit of size in `unit` ("b", "k", "m", "g", "t" or larger), using the colors of size ranges ("nb"-"nt" and "ub"-"ut") of EXAColorAttributes if set, otherwise Csnp and Csup.
+func SizeUnitColors(unit string) (cnum, cunit *Color) {
+	u := strings.ToLower(unit)
+	if len(u) == 0 || !strings.Contains("bkmg", u[:1]) {
+		u = "t"
+	}
+	cnum, cunit = Csnp, Csup
+	if att, ok := EXAColorAttributes["n"+u[:1]]; ok {
+		cnum = NewColor(att...)
+	}
+	if att, ok := EXAColorAttributes["u"+u[:1]]; ok {
+		cunit = NewColor(att...)
+	}
+	return cnum, cunit
+}
+
+var (
+	// colorStyleNames are the names of styles of ParseColorSpec
+	colorStyleNames = map[string]Attribute{
+		"bold": 1, "faint": 2, "dim": 2, "italic": 3, "underline": 4,
+		"blink": 5, "reverse": 7, "hidden": 8, "strike": 9,
+	}
+	// colorNames are the names of standard colors of ParseColorSpec, prefixed by "bright-" for the high-intensity colors
+	colorNames = map[string]int{
+		"black": 0, "red": 1, "green": 2, "yellow": 3,
+		"blue": 4, "magenta": 5, "cyan": 6, "white": 7,
+	}
+	reSGRParams = regexp.MustCompile(`^\d+(;\d*)+$`)
+)
+
+// ParseColorSpec returns the SGR attributes of color `spec`, which is SGR parameters with ";" (e.g. "38;5;156;1") or words separated by spaces:
+//   - styles: bold, faint (dim), italic, underline, blink, reverse, hidden, strike
+//   - foreground: 256-color code (0-255), name (black, red, green, yellow, blue, magenta, cyan, white, and "bright-" prefixed) or 24-bit color in hex notation ("#rrggbb" or "#rgb")
+//   - background: "on" followed by a color, e.g. "on 236" or "on #1c1c1c"
+//
+// An empty spec or "default" means no color.
+func ParseColorSpec(spec string) ([]Attribute, error) {
+	spec = strings.TrimSpace(spec)
+	if reSGRParams.MatchString(spec) {
+		return SGRAttributes(spec), nil
+	}
+	var (
+		attrs = []Attribute{}
+		isBg  bool
+	)
+	for _, tok := range strings.Fields(strings.ToLower(spec)) {
+		if tok == "on" {
+			isBg = true
+			continue
+		}
+		if tok == "default" {
+			continue
+		}
+		if a, ok := colorStyleNames[tok]; ok {
+			attrs = append(attrs, a)
+			continue
+		}
+		var ca AttributeA
+		if strings.HasPrefix(tok, "#") {
+			r, g, b, err := ParseHexColor(tok)
+			if err != nil {
+				return nil, fmt.Errorf("%v in %q", err, spec)
+			}
+			ca = FgRGBA(r, g, b)
+		} else {
+			code, ok := colorCode(tok)
+			if !ok {
+				return nil, fmt.Errorf("invalid color %q in %q", tok, spec)
+			}
+			ca = FgColor256A(code)
+		}
+		if isBg {
+			ca[0] = 48
+			isBg = false
+		}
+		attrs = append(attrs, ca...)
+	}
+	if isBg {
+		return nil, fmt.Errorf("missing color after \"on\" in %q", spec)
+	}
+	return attrs, nil
+}
+
+// colorCode returns the 256-color code of `name` (0-255 or name of color)
+func colorCode(name string) (int, bool) {
+	if code, err := strconv.Atoi(name); err == nil {
+		return code, code >= 0 && code <= 255
+	}
+	if code, ok := colorNames[strings.TrimPrefix(name, "bright-")]; ok {
+		if strings.HasPrefix(name, "bright-") {
+			code += 8
+		}
+		return code, true
+	}
+	return 0, false
+}
+
+// LoadTheme reads the theme from file `path` in YAML (.yaml or .yml) or TOML (.toml); the name of theme is the base name of file if it is not set.
+func LoadTheme(path string) (*Theme, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		t   = new(Theme)
+		ext = strings.ToLower(filepath.Ext(path))
+	)
+	switch ext {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(b, t)
+	case ".toml":
+		err = toml.Unmarshal(b, t)
+	default:
+		err = errors.New("unsupported format of theme, use .yaml, .yml or .toml")
+	}
+	if err != nil {
+		return nil, &fs.PathError{Op: "LoadTheme", Path: path, Err: err}
+	}
+	if len(t.Name) == 0 {
+		t.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	return t, nil
+}
+
+// ApplyTheme restores the default colors, applies theme t (after its base theme) and then reloads the default colors (see ReloadColors). A nil theme restores the default colors.
+func ApplyTheme(t *Theme) error {
+	resetColorAttributes()
+	defer ReloadColors()
+	if t == nil {
+		return nil
+	}
+	if err := applyTheme(t, 0); err != nil {
+		resetColorAttributes()
+		return err
+	}
+	return nil
+}
+
+func applyTheme(t *Theme, depth int) error {
+	if len(t.Base) > 0 && !strings.EqualFold(t.Base, t.Name) {
+		base, ok := Themes[strings.ToLower(t.Base)]
+		if !ok {
+			return fmt.Errorf("theme %q: unknown base theme %q", t.Name, t.Base)
+		}
+		if depth > len(Themes) {
+			return fmt.Errorf("theme %q: loop of base themes", t.Name)
+		}
+		if err := applyTheme(base, depth+1); err != nil {
+			return err
+		}
+	}
+	if t.Reset {
+		clearColorAttributes()
+	}
+	for i, section := range []map[string]string{t.Fields, t.Permissions, t.Sizes, t.Git, t.Files} {
+		for key, spec := range section {
+			attrs, err := ParseColorSpec(spec)
+			if err != nil {
+				return fmt.Errorf("theme %q: %s: %w", t.Name, key, err)
+			}
+			setColorAttribute(key, attrs, i == 4)
+		}
+	}
+	return nil
+}
+
+// ThemeNames returns the sorted names of built-in themes
+func ThemeNames() []string {
+	names := make([]string, 0, len(Themes))
+	for name := range Themes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Themes are the built-in themes; "dark" is the default colors, "solarized" uses the 24-bit colors of Solarized (downsampled to TermColorDepth).
+var Themes = map[string]*Theme{
+	"dark": {Name: "dark"},
+	"light": {
+		Name: "light",
+		Fields: map[string]string{
+			"in": "127", "lk": "bold 130", "bk": "60",
+			"uu": "bold 94", "un": "242", "gu": "bold 94", "gn": "242",
+			"da": "25", "hd": "underline on 252", "dir": "60", "-": "246",
+			"md5": "240", "acl": "130", "cap": "bold 160", "ctx": "30",
+			"mime": "61", "ftype": "66", "lines": "100", "words": "101", "enc": "31",
+			"xattr": "underline 244 on 254", "xsymb": "244 on 254",
+			"pmpt": "238 on 254", "bgpmpt": "on 254", "pmptsn": "bold 28 on 254",
+			"pmptsu": "28 on 254", "pmptdash": "244 on 254",
+			"field": "130", "value": "underline 94",
+			"even": "232", "odd": "94", "evenH": "underline 232 on 254", "oddH": "underline 94 on 254",
+		},
+		Permissions: map[string]string{
+			"ur": "bold 130", "uw": "bold 160", "ux": "bold underline 28", "ue": "bold 28",
+			"gr": "bold 130", "gw": "bold 160", "gx": "bold underline 28",
+			"tr": "bold 130", "tw": "bold 160", "tx": "bold underline 28",
+		},
+		Sizes: map[string]string{
+			"sn": "bold 28", "snu": "127",
+			"nb": "28", "nk": "bold 28", "nm": "bold 30", "ng": "bold 130", "nt": "bold 160",
+		},
+		Git: map[string]string{
+			"ga": "28", "gm": "25", "gd": "160", "gv": "130", "gt": "127",
+		},
+		Files: map[string]string{
+			"di": "bold 25", "fi": "236", "ln": "30", "ex": "bold 28",
+			"or": "bold 160", "pi": "130", "so": "127",
+			"bd": "bold 94", "cd": "94",
+		},
+	},
+	"solarized": {
+		Name: "solarized",
+		Fields: map[string]string{
+			"in": "#d33682", "lk": "bold #cb4b16", "bk": "#6c71c4",
+			"uu": "bold #b58900", "un": "#586e75", "gu": "bold #b58900", "gn": "#586e75",
+			"da": "#268bd2", "hd": "underline #839496 on #073642", "dir": "#6c71c4", "-": "#586e75",
+			"md5": "#657b83", "acl": "#b58900", "cap": "bold #dc322f", "ctx": "#2aa198",
+			"mime": "#6c71c4", "ftype": "#2aa198", "lines": "#b58900", "words": "#cb4b16", "enc": "#268bd2",
+			"xattr": "underline #586e75 on #073642", "xsymb": "#586e75 on #073642",
+			"pmpt": "#839496 on #073642", "bgpmpt": "on #073642", "pmptsn": "bold #859900 on #073642",
+			"pmptsu": "#859900 on #073642", "pmptdash": "#586e75 on #073642",
+			"field": "#cb4b16", "value": "underline #b58900",
+			"even": "#839496", "odd": "#b58900", "evenH": "underline #839496 on #073642", "oddH": "underline #b58900 on #073642",
+		},
+		Permissions: map[string]string{
+			"ur": "bold #b58900", "uw": "bold #cb4b16", "ux": "bold underline #859900", "ue": "bold #859900",
+			"gr": "bold #b58900", "gw": "bold #cb4b16", "gx": "bold underline #859900",
+			"tr": "bold #b58900", "tw": "bold #cb4b16", "tx": "bold underline #859900",
+		},
+		Sizes: map[string]string{
+			"sn": "bold #859900", "snu": "#2aa198",
+			"nb": "#839496", "nk": "#859900", "nm": "bold #2aa198", "ng": "bold #b58900", "nt": "bold #cb4b16",
+		},
+		Git: map[string]string{
+			"ga": "#859900", "gm": "#268bd2", "gd": "#dc322f", "gv": "#b58900", "gt": "#d33682",
+		},
+		Files: map[string]string{
+			"di": "bold #268bd2", "fi": "#839496", "ln": "#2aa198", "ex": "bold #859900",
+			"or": "bold #dc322f", "pi": "#b58900", "so": "#d33682",
+			"bd": "bold #cb4b16", "cd": "#cb4b16",
+		},
+	},
+	"monochrome": {
+		Name:  "monochrome",
+		Reset: true,
+		Fields: map[string]string{
+			"hd": "underline", "uu": "bold", "gu": "bold", "-": "faint",
+			"xattr": "underline", "cap": "bold", "pmptsn": "bold", "evenH": "underline", "oddH": "underline",
+			"error": "bold reverse", "fatal": "bold reverse", "panic": "bold reverse", "warn": "bold",
+		},
+		Permissions: map[string]string{
+			"uw": "bold", "ux": "underline", "gw": "bold", "gx": "underline", "tw": "bold", "tx": "underline",
+		},
+		Sizes: map[string]string{
+			"sn": "bold",
+		},
+		Git: map[string]string{
+			"ga": "bold", "gm": "bold", "gd": "bold strike", "gv": "italic", "gt": "italic",
+		},
+		Files: map[string]string{
+			"di": "bold", "ln": "italic", "ex": "underline", "or": "strike",
+		},
+	},
+}
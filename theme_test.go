@@ -0,0 +1,42 @@
+package paw
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseColorSpec(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		spec  string
+		want  []Attribute
+		isErr bool
+	}{
+		{"", []Attribute{}, false},
+		{"default", []Attribute{}, false},
+		{"38;5;156;1", []Attribute{38, 5, 156, 1}, false},
+		{"bold 209", []Attribute{1, 38, 5, 209}, false},
+		{"underline 8 on 236", []Attribute{4, 38, 5, 8, 48, 5, 236}, false},
+		{"Red", []Attribute{38, 5, 1}, false},
+		{"bright-red on blue", []Attribute{38, 5, 9, 48, 5, 4}, false},
+		{"#ff8000", []Attribute{38, 2, 255, 128, 0}, false},
+		{"on #fff", []Attribute{48, 2, 255, 255, 255}, false},
+		{"dim italic strike", []Attribute{2, 3, 9}, false},
+		{"on", nil, true},
+		{"bold on", nil, true},
+		{"256", nil, true},
+		{"purple", nil, true},
+		{"#ggg", nil, true},
+	}
+	for _, tt := range tests {
+		attrs, err := ParseColorSpec(tt.spec)
+		if tt.isErr {
+			assert.Error(err, tt.spec)
+			continue
+		}
+		assert.NoError(err, tt.spec)
+		assert.Equal(tt.want, attrs, tt.spec)
+	}
+}
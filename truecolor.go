@@ -0,0 +1,193 @@
+package paw
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+// ColorDepth is the number of colors supported by terminal
+type ColorDepth int
+
+const (
+	// ColorDepth16 supports the 16 standard and high-intensity colors (SGR 30-37, 90-97)
+	ColorDepth16 ColorDepth = iota
+	// ColorDepth256 supports 256 colors (SGR 38;5;n)
+	ColorDepth256
+	// ColorDepthTrue supports 24-bit colors (SGR 38;2;r;g;b)
+	ColorDepthTrue
+)
+
+func (d ColorDepth) String() string {
+	switch d {
+	case ColorDepth16:
+		return "16 colors"
+	case ColorDepth256:
+		return "256 colors"
+	default:
+		return "truecolor"
+	}
+}
+
+// TermColorDepth is the color depth of terminal (see DetectColorDepth); the colors of EXAColorAttributes and LSColorAttributes are downsampled to it by NewEXAColor and NewLSColor.
+var TermColorDepth = DetectColorDepth()
+
+// DetectColorDepth returns the color depth of terminal from COLORTERM ("truecolor" or "24bit") and then the name of terminfo in TERM (e.g. "xterm-direct", "xterm-256color" or "linux"); it is ColorDepth256 if unknown.
+func DetectColorDepth() ColorDepth {
+	switch strings.ToLower(os.Getenv("COLORTERM")) {
+	case "truecolor", "24bit":
+		return ColorDepthTrue
+	}
+	term := strings.ToLower(os.Getenv("TERM"))
+	switch {
+	case strings.Contains(term, "direct"),
+		strings.Contains(term, "truecolor"),
+		strings.Contains(term, "24bit"):
+		return ColorDepthTrue
+	case strings.Contains(term, "256color"):
+		return ColorDepth256
+	}
+	switch term {
+	case "linux", "ansi", "vt100", "vt220", "cons25", "xterm-color", "xterm-16color":
+		return ColorDepth16
+	}
+	return ColorDepth256
+}
+
+// FgRGB returns foreground 24-bit color (use fatih.color)
+func FgRGB(r, g, b uint8) *Color {
+	return color.New(FgRGBA(r, g, b)...)
+}
+
+// FgRGBA returns attributes of foreground 24-bit color, i.e. 38;2;r;g;b
+func FgRGBA(r, g, b uint8) AttributeA {
+	return AttributeA{38, 2, Attribute(r), Attribute(g), Attribute(b)}
+}
+
+// BgRGB returns background 24-bit color (use fatih.color)
+func BgRGB(r, g, b uint8) *Color {
+	return color.New(BgRGBA(r, g, b)...)
+}
+
+// BgRGBA returns attributes of background 24-bit color, i.e. 48;2;r;g;b
+func BgRGBA(r, g, b uint8) AttributeA {
+	return AttributeA{48, 2, Attribute(r), Attribute(g), Attribute(b)}
+}
+
+// ParseHexColor returns the RGB of hex notation `hex`, "#rrggbb" or "#rgb"
+func ParseHexColor(hex string) (r, g, b uint8, err error) {
+	s := strings.TrimPrefix(hex, "#")
+	if len(s) == 3 {
+		s = string([]byte{s[0], s[0], s[1], s[1], s[2], s[2]})
+	}
+	if len(s) != 6 {
+		return 0, 0, 0, fmt.Errorf("invalid hex color %q", hex)
+	}
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("invalid hex color %q", hex)
+	}
+	return uint8(v >> 16), uint8(v >> 8), uint8(v), nil
+}
+
+// RGBToColor256 returns the nearest 256-color code (16-255: the color cube or grays) of RGB
+func RGBToColor256(r, g, b uint8) int {
+	cubeIndex := func(v uint8) int {
+		switch {
+		case v < 48:
+			return 0
+		case v < 115:
+			return 1
+		default:
+			return (int(v) - 35) / 40
+		}
+	}
+	ir, ig, ib := cubeIndex(r), cubeIndex(g), cubeIndex(b)
+	cube := 16 + 36*ir + 6*ig + ib
+
+	avg := (int(r) + int(g) + int(b)) / 3
+	gi := 23
+	if avg < 238 {
+		gi = MaxInt((avg-3)/10, 0)
+	}
+	gray := 232 + gi
+
+	if rgbDistance(r, g, b, gray) < rgbDistance(r, g, b, cube) {
+		return gray
+	}
+	return cube
+}
+
+// RGBToColor16 returns the nearest code (0-15) of the 16 standard and high-intensity colors of RGB
+func RGBToColor16(r, g, b uint8) int {
+	code, min := 0, -1
+	for i := 0; i < 16; i++ {
+		if d := rgbDistance(r, g, b, i); min < 0 || d < min {
+			code, min = i, d
+		}
+	}
+	return code
+}
+
+// rgbDistance returns the squared distance between RGB and 256-color `code`
+func rgbDistance(r, g, b uint8, code int) int {
+	cr, cg, cb := Color256RGB(code)
+	dr, dg, db := int(r)-int(cr), int(g)-int(cg), int(b)-int(cb)
+	return dr*dr + dg*dg + db*db
+}
+
+// DownsampleAttributes returns SGR attributes `attrs` with the 24-bit (38;2;r;g;b) and 256 (38;5;n) colors converted to the nearest colors of `depth`
+func DownsampleAttributes(attrs []Attribute, depth ColorDepth) []Attribute {
+	if depth == ColorDepthTrue {
+		return attrs
+	}
+	as := make([]Attribute, 0, len(attrs))
+	for i := 0; i < len(attrs); i++ {
+		a := attrs[i]
+		if a != 38 && a != 48 {
+			as = append(as, a)
+			continue
+		}
+		rest := attrs[i+1:]
+		var r, g, b uint8
+		switch {
+		case len(rest) >= 2 && rest[0] == 5:
+			if depth == ColorDepth256 {
+				as = append(as, a, 5, rest[1])
+				i += 2
+				continue
+			}
+			r, g, b = Color256RGB(int(rest[1]))
+			i += 2
+		case len(rest) >= 4 && rest[0] == 2:
+			r, g, b = uint8(rest[1]), uint8(rest[2]), uint8(rest[3])
+			i += 4
+		default:
+			// malformed, keep the rest
+			return append(as, attrs[i:]...)
+		}
+		if depth == ColorDepth256 {
+			as = append(as, a, 5, Attribute(RGBToColor256(r, g, b)))
+			continue
+		}
+		code := Attribute(RGBToColor16(r, g, b))
+		base := Attribute(30)
+		if a == 48 {
+			base = 40
+		}
+		if code >= 8 {
+			base += 60
+			code -= 8
+		}
+		as = append(as, base+code)
+	}
+	return as
+}
+
+// NewColor returns the color of `attrs` downsampled to TermColorDepth (use fatih.color)
+func NewColor(attrs ...Attribute) *Color {
+	return color.New(DownsampleAttributes(attrs, TermColorDepth)...)
+}
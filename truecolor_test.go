@@ -0,0 +1,29 @@
+package paw
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDownsampleAttributes(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		attrs []Attribute
+		depth ColorDepth
+		want  []Attribute
+	}{
+		{[]Attribute{1, 38, 2, 255, 0, 0}, ColorDepthTrue, []Attribute{1, 38, 2, 255, 0, 0}},
+		{[]Attribute{1, 38, 2, 255, 0, 0}, ColorDepth256, []Attribute{1, 38, 5, 196}},
+		{[]Attribute{48, 2, 128, 128, 128}, ColorDepth256, []Attribute{48, 5, 244}},
+		{[]Attribute{38, 5, 156}, ColorDepth256, []Attribute{38, 5, 156}},
+		{[]Attribute{1, 38, 2, 255, 0, 0}, ColorDepth16, []Attribute{1, 91}},
+		{[]Attribute{38, 5, 1, 48, 5, 0}, ColorDepth16, []Attribute{31, 40}},
+		{[]Attribute{48, 2, 255, 255, 255}, ColorDepth16, []Attribute{107}},
+		{[]Attribute{4, 38, 5}, ColorDepth16, []Attribute{4, 38, 5}},
+	}
+	for _, tt := range tests {
+		assert.Equal(tt.want, DownsampleAttributes(tt.attrs, tt.depth), "%v to %v", tt.attrs, tt.depth)
+	}
+}
@@ -0,0 +1,293 @@
+package vfs
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/shyang107/paw"
+)
+
+// CompareStatus is the status of an entry in the comparison of two VFS, A and B; the differences are combined by bits.
+type CompareStatus int
+
+const (
+	// CompareIdentical is the entry in both A and B without any difference
+	CompareIdentical CompareStatus = 0
+	// CompareOnlyA is the entry only in A
+	CompareOnlyA CompareStatus = 1 << iota
+	// CompareOnlyB is the entry only in B
+	CompareOnlyB
+	// CompareDiffType is the entry of different types, e.g. a file in A and a directory in B
+	CompareDiffType
+	// CompareDiffSize is the file of different sizes
+	CompareDiffSize
+	// CompareDiffMTime is the file of different modified times (in seconds)
+	CompareDiffMTime
+	// CompareDiffMode is the entry of different permissions
+	CompareDiffMode
+	// CompareDiffChecksum is the file of the same size but different md5 checksums
+	CompareDiffChecksum
+	// CompareDiffContent is the directory whose entries are different
+	CompareDiffContent
+)
+
+var compareStatusNames = []struct {
+	s    CompareStatus
+	name string
+}{
+	{CompareOnlyA, "only in A"},
+	{CompareOnlyB, "only in B"},
+	{CompareDiffType, "type"},
+	{CompareDiffSize, "size"},
+	{CompareDiffMTime, "mtime"},
+	{CompareDiffMode, "mode"},
+	{CompareDiffChecksum, "checksum"},
+	{CompareDiffContent, "content"},
+}
+
+func (s CompareStatus) String() string {
+	if s == CompareIdentical {
+		return "identical"
+	}
+	names := make([]string, 0, 2)
+	for _, n := range compareStatusNames {
+		if s&n.s != 0 {
+			names = append(names, n.name)
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+// IsDifferent returns true if the entry is in both A and B but different
+func (s CompareStatus) IsDifferent() bool {
+	return s != CompareIdentical && s&(CompareOnlyA|CompareOnlyB) == 0
+}
+
+// Mark returns the colorful mark of s: "=" identical, "<" only in A, ">" only in B and "≠" different
+func (s CompareStatus) Mark() string {
+	switch {
+	case s == CompareIdentical:
+		return paw.Cdashp.Sprint("=")
+	case s&CompareOnlyA != 0:
+		return GitDeleted.Color().Sprint("<")
+	case s&CompareOnlyB != 0:
+		return GitAdded.Color().Sprint(">")
+	}
+	return GitModified.Color().Sprint("≠")
+}
+
+// CompareOption is the option of CompareVFS
+type CompareOption struct {
+	// IsChecksum compares md5 checksums of the files of the same size
+	IsChecksum bool
+	// IsIgnoreMTime does not compare modified times of files
+	IsIgnoreMTime bool
+	// IsIgnoreMode does not compare permissions
+	IsIgnoreMode bool
+	// IsHideIdentical hides the identical entries (and the directories whose entries are all identical)
+	IsHideIdentical bool
+}
+
+// CompareEntry is an entry of the comparison aligned by relative path; A or B is nil if the entry is not in it.
+type CompareEntry struct {
+	RelPath  string
+	Name     string
+	A, B     DirEntryX
+	Status   CompareStatus
+	Children []*CompareEntry
+}
+
+// Comparison is the comparison of two VFS, A and B, by relative path (like as `diff -rq`)
+type Comparison struct {
+	A, B *VFS
+	Root *CompareEntry
+	opt  *CompareOption
+}
+
+// CompareVFS compares the built VFS a and b entry by entry aligned by relative path; the entries of each directory are ordered by lower name.
+func CompareVFS(a, b *VFS, opt *CompareOption) *Comparison {
+	if opt == nil {
+		opt = &CompareOption{}
+	}
+	root := &CompareEntry{RelPath: ".", Name: ".", A: a.RootDir(), B: b.RootDir()}
+	compareDir(root, opt)
+	return &Comparison{A: a, B: b, Root: root, opt: opt}
+}
+
+// compareDir compares the children of directories e.A and e.B (either may be nil), and sets CompareDiffContent to e if they are not all identical.
+func compareDir(e *CompareEntry, opt *CompareOption) {
+	children := map[string]*CompareEntry{}
+	add := func(dx DirEntryX, isA bool) {
+		d, ok := dx.(*Dir)
+		if !ok {
+			return
+		}
+		des, _ := d.ReadDirAll()
+		for _, de := range des {
+			c, ok := children[de.Name()]
+			if !ok {
+				c = &CompareEntry{RelPath: de.RelPath(), Name: de.Name()}
+				children[de.Name()] = c
+			}
+			if isA {
+				c.A = de
+			} else {
+				c.B = de
+			}
+		}
+	}
+	add(e.A, true)
+	add(e.B, false)
+
+	e.Children = make([]*CompareEntry, 0, len(children))
+	for _, c := range children {
+		c.Status = compareEntry(c.A, c.B, opt)
+		if c.A != nil && c.A.IsDir() || c.B != nil && c.B.IsDir() {
+			compareDir(c, opt)
+		}
+		if c.Status != CompareIdentical && e.Status&(CompareOnlyA|CompareOnlyB) == 0 {
+			e.Status |= CompareDiffContent
+		}
+		e.Children = append(e.Children, c)
+	}
+	sort.Slice(e.Children, func(i, j int) bool {
+		return strings.ToLower(e.Children[i].Name) < strings.ToLower(e.Children[j].Name)
+	})
+}
+
+// compareEntry returns the status of a and b (either may be nil)
+func compareEntry(a, b DirEntryX, opt *CompareOption) (s CompareStatus) {
+	switch {
+	case b == nil:
+		return CompareOnlyA
+	case a == nil:
+		return CompareOnlyB
+	case a.Mode().Type() != b.Mode().Type():
+		return CompareDiffType
+	}
+	if !opt.IsIgnoreMode && a.Mode().Perm() != b.Mode().Perm() {
+		s |= CompareDiffMode
+	}
+	// the size and modified time of directory change with its entries, compare the entries instead
+	if a.IsDir() {
+		return s
+	}
+	if a.Mode()&fs.ModeSymlink != 0 {
+		if a.LinkPath() != b.LinkPath() {
+			s |= CompareDiffContent
+		}
+		return s
+	}
+	if a.Size() != b.Size() {
+		s |= CompareDiffSize
+	} else if opt.IsChecksum && a.Mode().IsRegular() && a.Md5() != b.Md5() {
+		s |= CompareDiffChecksum
+	}
+	if !opt.IsIgnoreMTime &&
+		!a.ModifiedTime().Truncate(time.Second).Equal(b.ModifiedTime().Truncate(time.Second)) {
+		s |= CompareDiffMTime
+	}
+	return s
+}
+
+// isVisible returns true if e is viewed under c.opt
+func (c *Comparison) isVisible(e *CompareEntry) bool {
+	return !c.opt.IsHideIdentical || e.Status != CompareIdentical
+}
+
+// Counts returns the numbers of entries of identical, different, only in A and only in B; a directory only in A (or B) is counted with its entries.
+func (c *Comparison) Counts() (nsame, ndiff, nonlyA, nonlyB int) {
+	var count func(e *CompareEntry)
+	count = func(e *CompareEntry) {
+		for _, ce := range e.Children {
+			switch {
+			case ce.Status == CompareIdentical:
+				nsame++
+			case ce.Status&CompareOnlyA != 0:
+				nonlyA++
+			case ce.Status&CompareOnlyB != 0:
+				nonlyB++
+			case ce.Status&^CompareDiffContent != 0:
+				// the directory which is different only by its entries is not counted
+				ndiff++
+			}
+			count(ce)
+		}
+	}
+	count(c.Root)
+	return nsame, ndiff, nonlyA, nonlyB
+}
+
+// View views the comparison in two columns of trees, A on the left and B on the right, aligned by relative path; each row is marked by CompareStatus.Mark and the different row ends with the differences.
+func (c *Comparison) View(w io.Writer) {
+	var (
+		wdstty = sttyWidth - 2
+		wdcol  = (wdstty - 5) / 2
+	)
+	w = paw.NewColorWriter(w, c.A.opt.ColorMode)
+
+	fmt.Fprintf(w, "%s %s\n", paw.Cfield.Sprint("A:"), PathTo(c.A.RootDir(), &PathToOption{true, nil, PRTPathToLink}))
+	fmt.Fprintf(w, "%s %s\n", paw.Cfield.Sprint("B:"), PathTo(c.B.RootDir(), &PathToOption{true, nil, PRTPathToLink}))
+	FprintBanner(w, "", "=", wdstty)
+	c.viewChildren(w, c.Root, "", 0, wdcol)
+	FprintBanner(w, "", "=", wdstty)
+
+	nsame, ndiff, nonlyA, nonlyB := c.Counts()
+	fmt.Fprintln(w, paw.CpmptSn.Sprint(nsame)+paw.Cpmpt.Sprint(" identical, ")+
+		paw.CpmptSn.Sprint(ndiff)+paw.Cpmpt.Sprint(" different, ")+
+		paw.CpmptSn.Sprint(nonlyA)+paw.Cpmpt.Sprint(" only in A and ")+
+		paw.CpmptSn.Sprint(nonlyB)+paw.Cpmpt.Sprint(" only in B."))
+}
+
+func (c *Comparison) viewChildren(w io.Writer, e *CompareEntry, pad string, wdpad, wdcol int) {
+	children := make([]*CompareEntry, 0, len(e.Children))
+	for _, ce := range e.Children {
+		if c.isVisible(ce) {
+			children = append(children, ce)
+		}
+	}
+	for i, ce := range children {
+		edge, next := EdgeTypeMid, string(EdgeTypeLink)+SpaceIndentSize
+		if i == len(children)-1 {
+			edge, next = EdgeTypeEnd, " "+SpaceIndentSize
+		}
+		cpad := pad + paw.Cdashp.Sprint(edge) + " "
+		wdcpad := wdpad + edgeWidth[edge] + 1
+		detail := ""
+		if ce.Status.IsDifferent() && ce.Status != CompareDiffContent {
+			detail = " " + GitModified.Color().Sprint("["+ce.Status.String()+"]")
+		}
+		fmt.Fprintf(w, "%s %s%s%s%s\n",
+			ce.Status.Mark(),
+			compareCell(ce.A, cpad, wdcpad, wdcol),
+			paw.Cdashp.Sprint(" │ "),
+			strings.TrimRight(compareCell(ce.B, cpad, wdcpad, wdcol), " "),
+			detail)
+		if len(ce.Children) > 0 {
+			c.viewChildren(w, ce, pad+paw.Cdashp.Sprint(next), wdpad+IndentSize+1, wdcol)
+		}
+	}
+}
+
+// compareCell returns the cell of de with the tree prefix `pad` (of width wdpad) in width wdcol; the name is truncated if it is too long, and the cell of nil de is the prefix only.
+func compareCell(de DirEntryX, pad string, wdpad, wdcol int) string {
+	name := ""
+	if de != nil {
+		name = quote(de, de.Name())
+		if de.IsDir() {
+			name += "/"
+		}
+		if wd := wdcol - wdpad; paw.StringWidth(name) > wd {
+			name = paw.Truncate(name, paw.MaxInt(wd, 1), "…")
+		}
+	}
+	cell := pad
+	if len(name) > 0 {
+		cell += hyperlinkC(de, GetDexLSColor(de).Sprint(name))
+	}
+	return cell + paw.Spaces(wdcol-wdpad-paw.StringWidth(name))
+}
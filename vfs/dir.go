@@ -37,7 +37,6 @@ type Dir struct {
 	errors   []error
 	// ReadDir 遍歷用
 	idx int
-	opt *VFSOption
 	//
 }
 
@@ -59,13 +58,13 @@ func NewDir(dirpath, root string, git *GitStatus, opt *VFSOption) (*Dir, error)
 			Err:  err,
 		}
 	}
+	f.opt = opt
 	return &Dir{
 		File: *f,
 		// relpaths: []string{},
 		relpaths: []string{f.relpath},
 		// errors:   []error{},
 		children: make(map[string]DirEntryX),
-		opt:      opt,
 	}, nil
 }
 
@@ -311,6 +310,14 @@ func (d *Dir) Md5() string {
 	return "-"
 }
 
+// FileType returns the type of Dir, "inode/directory"
+func (d *Dir) FileType() *paw.FileType {
+	if d.ftype == nil {
+		d.ftype = detectFileType(d)
+	}
+	return d.ftype
+}
+
 func (d *Dir) Git() *GitStatus {
 	return d.git
 }
@@ -344,6 +351,18 @@ func (d *Dir) Field(field ViewField) string {
 		return d.XY()
 	case ViewFieldMd5:
 		return d.Md5()
+	case ViewFieldACL:
+		return aclShortS(d.ACL())
+	case ViewFieldCapability:
+		return securityS(d.Capability())
+	case ViewFieldContext:
+		return securityS(d.SecurityContext())
+	case ViewFieldMimeType:
+		return d.FileType().Mime
+	case ViewFieldFileType:
+		return d.FileType().Desc
+	case ViewFieldLines, ViewFieldWords, ViewFieldEncoding:
+		return "-"
 	case ViewFieldName:
 		return d.Name()
 	default:
@@ -493,7 +512,7 @@ func (d *Dir) ReadDir(n int) ([]DirEntryX, error) {
 	files := make([]DirEntryX, 0)
 	for i := d.idx; i < n && i < totalEntries; i++ {
 		child := d.children[names[i]]
-		if d.opt.Grouping == GroupNone {
+		if d.opt.Grouping != Grouped && d.opt.Grouping != GroupedR {
 			dxs = append(dxs, child)
 		} else { //grouping items
 			if child.IsDir() {
@@ -506,8 +525,9 @@ func (d *Dir) ReadDir(n int) ([]DirEntryX, error) {
 	}
 
 	// 2. sort items
-	if d.opt.Grouping == GroupNone {
+	if d.opt.Grouping != Grouped && d.opt.Grouping != GroupedR {
 		d.opt.Sort(dxs)
+		d.opt.Grouping.SortSections(dxs)
 	} else { //grouping items
 		d.opt.Sort(dirs)
 		d.opt.Sort(files)
@@ -549,9 +569,11 @@ func (d *Dir) SetOption(opt *VFSOption) {
 func _SetOption(cur *Dir, opt *VFSOption) {
 	cur.opt = opt
 	for _, dx := range cur.children {
-		if dx.IsDir() {
-			child := dx.(*Dir)
+		switch child := dx.(type) {
+		case *Dir:
 			_SetOption(child, opt)
+		case *File:
+			child.opt = opt
 		}
 	}
 }
@@ -693,6 +715,46 @@ func calcSize(cur *Dir, level int) (size int64) {
 	return size
 }
 
+// TextTotal returns the number of text files and their total lines and words in Dir; it is recursive if isRecurse is true.
+func (d *Dir) TextTotal(isRecurse bool) (ntexts, lines, words int) {
+	if d.opt.ViewType&ViewNoFiles != 0 {
+		return 0, 0, 0
+	}
+	level := 0
+	if d.RelPath() != "." {
+		level = len(strings.Split(d.RelPath(), "/"))
+	}
+	tt := new(textTotal)
+	calcTextTotal(d, level, isRecurse, tt)
+	return tt.ntexts, tt.lines, tt.words
+}
+
+// textTotal returns the totals of text files used in summary, or nil if neither ViewFieldLines nor ViewFieldWords is viewed, because they read all text files.
+func (d *Dir) textTotal(isRecurse bool) *textTotal {
+	if d.opt.ViewFields&(ViewFieldLines|ViewFieldWords) == 0 {
+		return nil
+	}
+	tt := new(textTotal)
+	tt.ntexts, tt.lines, tt.words = d.TextTotal(isRecurse)
+	return tt
+}
+
+func calcTextTotal(cur *Dir, level int, isRecurse bool, tt *textTotal) {
+	if cur.opt.Depth > 0 && level > cur.opt.Depth {
+		return
+	}
+	dxs, _ := cur.ReadDirAll()
+	for _, de := range dxs {
+		if de.IsDir() {
+			if isRecurse {
+				calcTextTotal(de.(*Dir), level+1, isRecurse, tt)
+			}
+			continue
+		}
+		tt.add(de)
+	}
+}
+
 func (d *Dir) SetGit(git *GitStatus) {
 	d.git = git
 }
@@ -898,11 +960,12 @@ func (d *Dir) SummaryC(pad string, wdstty int, isRecurse bool) string {
 	var (
 		ndirs, nfiles, _ = d.NItems(isRecurse)
 		tsize            = d.TotalSize()
+		tt               = d.textTotal(isRecurse)
 	)
 	if isRecurse {
-		return totalSummary(pad, ndirs, nfiles, tsize, sttyWidth-2)
+		return totalTextSummary(pad, ndirs, nfiles, tsize, tt, sttyWidth-2)
 	} else {
-		return dirSummary(pad, ndirs, nfiles, tsize, sttyWidth-2)
+		return dirTextSummary(pad, ndirs, nfiles, tsize, tt, sttyWidth-2)
 	}
 }
 
@@ -3,6 +3,8 @@ package vfs
 import (
 	"io/fs"
 	"time"
+
+	"github.com/shyang107/paw"
 )
 
 type FileMode = fs.FileMode
@@ -12,6 +14,12 @@ type DirEntry = fs.DirEntry
 // Extended is a interface to get extended attributes from Dir or File
 type Extendeder interface {
 	Xattibutes() []string
+	XattrNames() []string
+	XattibuteValues() []string
+	ACL() []string
+	HasACL() bool
+	Capability() string
+	SecurityContext() string
 }
 
 type Fielder interface {
@@ -35,6 +43,8 @@ type Fielder interface {
 	CreatedTime() time.Time
 	ModifiedTime() time.Time
 	Md5() string
+	FileType() *paw.FileType
+	TextStat() *paw.TextStat
 	Git() *GitStatus
 	XY() string
 
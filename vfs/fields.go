@@ -41,6 +41,22 @@ const (
 	ViewFieldGit
 	// ViewFieldMd5 is md5 field
 	ViewFieldMd5
+	// ViewFieldACL is POSIX ACL field
+	ViewFieldACL
+	// ViewFieldCapability is file capabilities field
+	ViewFieldCapability
+	// ViewFieldContext is SELinux security context field
+	ViewFieldContext
+	// ViewFieldMimeType is MIME type field detected by content
+	ViewFieldMimeType
+	// ViewFieldFileType is file type (description) field detected by content
+	ViewFieldFileType
+	// ViewFieldLines is line count field of text file
+	ViewFieldLines
+	// ViewFieldWords is word count field of text file
+	ViewFieldWords
+	// ViewFieldEncoding is encoding (and BOM) field of text file
+	ViewFieldEncoding
 	// ViewFieldName is name field
 	ViewFieldName
 
@@ -85,6 +101,14 @@ var (
 		ViewFieldAccessed:    "Accessed",
 		ViewFieldGit:         "Git",
 		ViewFieldMd5:         "md5",
+		ViewFieldACL:         "ACL",
+		ViewFieldCapability:  "Capabilities",
+		ViewFieldContext:     "Security Context",
+		ViewFieldMimeType:    "MIME Type",
+		ViewFieldFileType:    "File Type",
+		ViewFieldLines:       "Lines",
+		ViewFieldWords:       "Words",
+		ViewFieldEncoding:    "Encoding",
 		ViewFieldName:        "Name",
 	}
 
@@ -104,6 +128,14 @@ var (
 		ViewFieldCreated:     len(dateS(time.Now())),
 		ViewFieldGit:         paw.MaxInt(3, len(ViewFieldNames[ViewFieldGit])),
 		ViewFieldMd5:         32,
+		ViewFieldACL:         len(ViewFieldNames[ViewFieldACL]),
+		ViewFieldCapability:  len(ViewFieldNames[ViewFieldCapability]),
+		ViewFieldContext:     len(ViewFieldNames[ViewFieldContext]),
+		ViewFieldMimeType:    len(ViewFieldNames[ViewFieldMimeType]),
+		ViewFieldFileType:    len(ViewFieldNames[ViewFieldFileType]),
+		ViewFieldLines:       len(ViewFieldNames[ViewFieldLines]),
+		ViewFieldWords:       len(ViewFieldNames[ViewFieldWords]),
+		ViewFieldEncoding:    len(ViewFieldNames[ViewFieldEncoding]),
 		ViewFieldName:        len(ViewFieldNames[ViewFieldName]),
 	}
 
@@ -121,6 +153,14 @@ var (
 		ViewFieldAccessed:    paw.Cdap,
 		ViewFieldGit:         paw.Cgitp,
 		ViewFieldMd5:         paw.Cmd5p,
+		ViewFieldACL:         paw.Caclp,
+		ViewFieldCapability:  paw.Ccapp,
+		ViewFieldContext:     paw.Cctxp,
+		ViewFieldMimeType:    paw.Cmimep,
+		ViewFieldFileType:    paw.Cftypep,
+		ViewFieldLines:       paw.Clinep,
+		ViewFieldWords:       paw.Cwordp,
+		ViewFieldEncoding:    paw.Cencp,
 		ViewFieldName:        paw.Cnop,
 	}
 
@@ -138,6 +178,14 @@ var (
 		ViewFieldAccessed:    paw.AlignLeft,
 		ViewFieldGit:         paw.AlignRight,
 		ViewFieldMd5:         paw.AlignLeft,
+		ViewFieldACL:         paw.AlignLeft,
+		ViewFieldCapability:  paw.AlignLeft,
+		ViewFieldContext:     paw.AlignLeft,
+		ViewFieldMimeType:    paw.AlignLeft,
+		ViewFieldFileType:    paw.AlignLeft,
+		ViewFieldLines:       paw.AlignRight,
+		ViewFieldWords:       paw.AlignRight,
+		ViewFieldEncoding:    paw.AlignLeft,
 		ViewFieldName:        paw.AlignLeft,
 	}
 
@@ -155,6 +203,14 @@ var (
 		ViewFieldAccessed:    "",
 		ViewFieldGit:         "",
 		ViewFieldMd5:         "",
+		ViewFieldACL:         "",
+		ViewFieldCapability:  "",
+		ViewFieldContext:     "",
+		ViewFieldMimeType:    "",
+		ViewFieldFileType:    "",
+		ViewFieldLines:       "",
+		ViewFieldWords:       "",
+		ViewFieldEncoding:    "",
 		ViewFieldName:        "",
 	}
 )
@@ -284,6 +340,31 @@ func (f ViewField) Slice() (fields []ViewField, names []string, nameWidths []int
 		fields = append(fields, ViewFieldAccessed)
 	}
 
+	if f&ViewFieldACL != 0 {
+		fields = append(fields, ViewFieldACL)
+	}
+	if f&ViewFieldCapability != 0 {
+		fields = append(fields, ViewFieldCapability)
+	}
+	if f&ViewFieldContext != 0 {
+		fields = append(fields, ViewFieldContext)
+	}
+	if f&ViewFieldMimeType != 0 {
+		fields = append(fields, ViewFieldMimeType)
+	}
+	if f&ViewFieldFileType != 0 {
+		fields = append(fields, ViewFieldFileType)
+	}
+	if f&ViewFieldLines != 0 {
+		fields = append(fields, ViewFieldLines)
+	}
+	if f&ViewFieldWords != 0 {
+		fields = append(fields, ViewFieldWords)
+	}
+	if f&ViewFieldEncoding != 0 {
+		fields = append(fields, ViewFieldEncoding)
+	}
+
 	if f&ViewFieldMd5 != 0 {
 		hasMd5 = true
 		fields = append(fields, ViewFieldMd5)
@@ -343,6 +424,14 @@ func (f ViewField) IsOk() (ok bool) {
 		f&ViewFieldCreated != 0 ||
 		f&ViewFieldAccessed != 0 ||
 		f&ViewFieldMd5 != 0 ||
+		f&ViewFieldACL != 0 ||
+		f&ViewFieldCapability != 0 ||
+		f&ViewFieldContext != 0 ||
+		f&ViewFieldMimeType != 0 ||
+		f&ViewFieldFileType != 0 ||
+		f&ViewFieldLines != 0 ||
+		f&ViewFieldWords != 0 ||
+		f&ViewFieldEncoding != 0 ||
 		f&ViewFieldGit != 0 ||
 		f&ViewFieldName != 0 ||
 		f&ViewFieldNo != 0 {
@@ -518,32 +607,30 @@ func (v ViewField) Rows(de DirEntryX) (values []string) {
 	// }
 	return values
 }
-func (v ViewField) XattibutesRowsC(de DirEntryX) (rows [][]string) {
-	xattrs := de.Xattibutes()
+func (v ViewField) XattibutesRowsC(de DirEntryX, isValue bool) (rows [][]string) {
+	xsymbs, xattrs := extendedAttrs(de, isValue)
 	if len(xattrs) == 0 {
 		return nil
 	}
 	fields := v.Fields()
 	nfd := len(fields)
 	rows = make([][]string, 0, len(xattrs))
-	cxs := make([]string, nfd)
-	for i := 0; i < nfd-1; i++ {
-		cxs[i] = paw.Spaces(fields[i].Width())
-
-	}
 	idx := nfd - 1
-	xsymb := "@ "
-	wdname := ViewFieldName.Width() - len(xsymb)
-	for _, x := range xattrs {
+	for i, x := range xattrs {
+		cxs := make([]string, nfd)
+		for j := 0; j < idx; j++ {
+			cxs[j] = paw.Spaces(fields[j].Width())
+		}
+		wdname := ViewFieldName.Width() - len(xsymbs[i])
 		sp := paw.Spaces(wdname - paw.StringWidth(x))
-		cxs[idx] = paw.Cxbp.Sprint(xsymb) + paw.Cxap.Sprint(x) + sp
+		cxs[idx] = paw.Cxbp.Sprint(xsymbs[i]) + paw.Cxap.Sprint(x) + sp
 		rows = append(rows, cxs)
 	}
 	return rows
 }
 
-func (v ViewField) XattibutesRowsSC(de DirEntryX) (rows []string) {
-	xrows := v.XattibutesRowsC(de)
+func (v ViewField) XattibutesRowsSC(de DirEntryX, isValue bool) (rows []string) {
+	xrows := v.XattibutesRowsC(de, isValue)
 	if len(xrows) == 0 {
 		return nil
 	}
@@ -22,10 +22,17 @@ type File struct {
 	name    string // basename
 	info    FileInfo
 	xattrs  []string
+	xnames  []string
+	sec     *securityAttrs
+	ftype   *paw.FileType
+	tstat   *paw.TextStat
 	git     *GitStatus
 	//
 	linkPath string
 	isLink   bool
+	isBroken bool // symbolic link pointing to a non-existent file
+	//
+	opt *VFSOption
 }
 
 func NewFile(path, root string, git *GitStatus) (*File, error) {
@@ -49,6 +56,16 @@ func NewFile(path, root string, git *GitStatus) (*File, error) {
 	return f, nil
 }
 
+// newFile creates the File of path by NewFile with the option opt used in views
+func newFile(path, root string, git *GitStatus, opt *VFSOption) (*File, error) {
+	f, err := NewFile(path, root, git)
+	if err != nil {
+		return nil, err
+	}
+	f.opt = opt
+	return f, nil
+}
+
 func _NewFile(path, root string, git *GitStatus) (*File, error) {
 	apath, err := filepath.Abs(path)
 	if err != nil {
@@ -62,9 +79,14 @@ func _NewFile(path, root string, git *GitStatus) (*File, error) {
 	}
 
 	var link string
-	isLink := false
+	isLink, isBroken := false, false
 	if info.Mode()&os.ModeSymlink != 0 {
-		info, _ = os.Stat(apath)
+		// keep the information of link itself if the target does not exist
+		if tinfo, err := os.Stat(apath); err == nil {
+			info = tinfo
+		} else {
+			isBroken = true
+		}
 		isLink = true
 		link = getLinkPath(apath)
 		if !filepath.IsAbs(link) { // get absolute path of link
@@ -78,32 +100,33 @@ func _NewFile(path, root string, git *GitStatus) (*File, error) {
 		relpath, _ = filepath.Rel(root, apath)
 	}
 	name := filepath.Base(apath)
-	xattrs, _ := _GetXattrs(apath)
+	xnames, xattrs, _ := _GetXattrs(apath)
 	return &File{
 		path:     apath,
 		relpath:  relpath,
 		name:     name,
 		info:     info,
 		xattrs:   xattrs,
+		xnames:   xnames,
 		git:      git,
 		isLink:   isLink,
+		isBroken: isBroken,
 		linkPath: link,
 	}, nil
 }
 
-func _GetXattrs(path string) ([]string, error) {
+func _GetXattrs(path string) (names, xattrs []string, err error) {
 	// paw.Logger.WithField("path", path).Info("income")
-	xattrs, err := xattr.List(path)
+	names, err = xattr.List(path)
 	if err != nil {
-		return xattrs, err
+		return names, nil, err
 	}
-	if len(xattrs) > 0 {
-		for i, x := range xattrs {
-			x, _ := xattr.Get(path, x)
-			xattrs[i] = fmt.Sprintf("%s (len %d)", xattrs[i], len(x))
-		}
+	xattrs = make([]string, len(names))
+	for i, name := range names {
+		x, _ := xattr.Get(path, name)
+		xattrs[i] = fmt.Sprintf("%s (len %d)", name, len(x))
 	}
-	return xattrs, nil
+	return names, xattrs, nil
 }
 
 // 實現 fs.FileInfo 接口
@@ -198,6 +221,66 @@ func (f *File) Xattibutes() []string {
 	return f.xattrs
 }
 
+// XattrNames get the names of extended attributes of File
+// 	implements the interface of Extended
+func (f *File) XattrNames() []string {
+	return f.xnames
+}
+
+// XattibuteValues get the extended attributes of File with their values, e.g. `user.tag = "release"`
+// 	implements the interface of Extended
+func (f *File) XattibuteValues() []string {
+	if f.xnames == nil {
+		return nil
+	}
+	xattrs := make([]string, 0, len(f.xnames))
+	for _, name := range f.xnames {
+		value, err := xattr.Get(f.path, name)
+		if err != nil {
+			xattrs = append(xattrs, name+" = ?")
+			continue
+		}
+		xattrs = append(xattrs, name+" = "+XattrValueS(value))
+	}
+	return xattrs
+}
+
+func (f *File) security() *securityAttrs {
+	if f.sec == nil {
+		// security attributes are stored in extended attributes
+		if len(f.xattrs) == 0 {
+			f.sec = &securityAttrs{}
+		} else {
+			f.sec = getSecurityAttrs(f.path)
+		}
+	}
+	return f.sec
+}
+
+// ACL returns the full POSIX ACL entries of File in the form of `getfacl`
+// 	implements the interface of Extended
+func (f *File) ACL() []string {
+	return f.security().acl
+}
+
+// HasACL returns true if File has extended POSIX ACL
+// 	implements the interface of Extended
+func (f *File) HasACL() bool {
+	return f.security().hasACL
+}
+
+// Capability returns the decoded file capabilities of File, e.g. "cap_net_bind_service+ep"
+// 	implements the interface of Extended
+func (f *File) Capability() string {
+	return f.security().capability
+}
+
+// SecurityContext returns the SELinux security context of File
+// 	implements the interface of Extended
+func (f *File) SecurityContext() string {
+	return f.security().context
+}
+
 //---------------------------------------------------------------------
 // 實現 Fielder 接口：
 
@@ -220,6 +303,16 @@ func (f *File) RelDir() string {
 	return filepath.Dir(f.RelPath())
 }
 
+// Option returns the VFSOption of File, it is nil if File is not created by VFS
+func (f *File) Option() *VFSOption {
+	return f.opt
+}
+
+// SetOption sets the VFSOption of File, e.g. the icon and the quoting style of its name in views
+func (f *File) SetOption(opt *VFSOption) {
+	f.opt = opt
+}
+
 // LSColor will return LS_COLORS color of File
 // 	implements the interface of DirEntryX
 func (f *File) LSColor() *Color {
@@ -356,6 +449,25 @@ func (f *File) Md5() string {
 	}
 }
 
+// FileType returns the type of File detected by content (magic numbers)
+func (f *File) FileType() *paw.FileType {
+	if f.ftype == nil {
+		f.ftype = detectFileType(f)
+	}
+	return f.ftype
+}
+
+// TextStat returns the statistics (lines, words and encoding) of File if it is a text file, otherwise returns nil
+func (f *File) TextStat() *paw.TextStat {
+	if f.tstat == nil {
+		f.tstat = textStatOf(f)
+	}
+	if f.tstat == noTextStat {
+		return nil
+	}
+	return f.tstat
+}
+
 func (f *File) Git() *GitStatus {
 	return f.git
 }
@@ -392,6 +504,18 @@ func (f *File) Field(field ViewField) string {
 		return f.XY()
 	case ViewFieldMd5:
 		return f.Md5()
+	case ViewFieldACL:
+		return aclShortS(f.ACL())
+	case ViewFieldCapability:
+		return securityS(f.Capability())
+	case ViewFieldContext:
+		return securityS(f.SecurityContext())
+	case ViewFieldMimeType:
+		return f.FileType().Mime
+	case ViewFieldFileType:
+		return f.FileType().Desc
+	case ViewFieldLines, ViewFieldWords, ViewFieldEncoding:
+		return textStatS(f, field)
 	case ViewFieldName:
 		return f.NameToLink() //f.Name()
 	default:
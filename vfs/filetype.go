@@ -0,0 +1,123 @@
+package vfs
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/shyang107/paw"
+	"github.com/shyang107/paw/cast"
+)
+
+// detectFileType returns the type of de detected by content (magic numbers); special files (directory, device, etc.) use the "inode/*" types like as `file --mime-type`.
+func detectFileType(de DirEntryX) *paw.FileType {
+	switch {
+	case de.IsDir():
+		return &paw.FileType{Mime: "inode/directory", Desc: "directory"}
+	case de.IsLink() && isBrokenLink(de):
+		return &paw.FileType{Mime: "inode/symlink", Desc: "broken symbolic link"}
+	case de.IsCharDev():
+		return &paw.FileType{Mime: "inode/chardevice", Desc: "character special"}
+	case de.IsDev():
+		return &paw.FileType{Mime: "inode/blockdevice", Desc: "block special"}
+	case de.IsFIFO():
+		return &paw.FileType{Mime: "inode/fifo", Desc: "fifo (named pipe)"}
+	case de.IsSocket():
+		return &paw.FileType{Mime: "inode/socket", Desc: "socket"}
+	}
+	ft, err := paw.DetectFileType(de.Path())
+	if err != nil {
+		desc := "unknown"
+		if os.IsPermission(err) {
+			desc = "permission denied"
+		}
+		return &paw.FileType{Mime: "application/x-unknown", Desc: desc}
+	}
+	return ft
+}
+
+// noTextStat marks the cached TextStat of non-text file
+var noTextStat = &paw.TextStat{Lines: -1, Words: -1}
+
+// textStatOf returns the statistics of text file de; returns noTextStat if de is not a regular text file or can not be read.
+func textStatOf(de DirEntryX) *paw.TextStat {
+	if !de.Mode().IsRegular() || !de.FileType().IsText() {
+		return noTextStat
+	}
+	st, err := paw.FileTextStat(de.Path())
+	if err != nil {
+		return noTextStat
+	}
+	return st
+}
+
+// textLines returns the line count of de, or -1 if de is not a text file
+func textLines(de DirEntryX) int {
+	if st := de.TextStat(); st != nil {
+		return st.Lines
+	}
+	return -1
+}
+
+// textStatS returns the string of field (ViewFieldLines, ViewFieldWords or ViewFieldEncoding) of text file de, or "-" for others
+func textStatS(de DirEntryX, field ViewField) string {
+	st := de.TextStat()
+	if st == nil {
+		return "-"
+	}
+	switch field {
+	case ViewFieldLines:
+		return cast.ToString(st.Lines)
+	case ViewFieldWords:
+		return cast.ToString(st.Words)
+	case ViewFieldEncoding:
+		return st.EncodingS()
+	default:
+		return "-"
+	}
+}
+
+// textTotal is the totals of text files
+type textTotal struct {
+	ntexts int
+	lines  int
+	words  int
+}
+
+func (t *textTotal) add(de DirEntryX) {
+	if st := de.TextStat(); st != nil {
+		t.ntexts++
+		t.lines += st.Lines
+		t.words += st.Words
+	}
+}
+
+// summaryC returns the colorful string of totals used in summary, or "" if t is nil
+func (t *textTotal) summaryC() string {
+	if t == nil {
+		return ""
+	}
+	return paw.Cpmpt.Sprint(", ") +
+		paw.CpmptSn.Sprint(t.lines) +
+		paw.Cpmpt.Sprint(" lines and ") +
+		paw.CpmptSn.Sprint(t.words) +
+		paw.Cpmpt.Sprint(" words in ") +
+		paw.CpmptSn.Sprint(t.ntexts) +
+		paw.Cpmpt.Sprint(" text files")
+}
+
+// mimeS returns MIME type without parameters (e.g. "; charset=utf-8")
+func mimeS(ft *paw.FileType) string {
+	return strings.TrimSpace(strings.Split(ft.Mime, ";")[0])
+}
+
+// hasMimeOf returns true if MIME type of de matches one of patterns (see filepath.Match, e.g. "image/*")
+func hasMimeOf(de DirEntryX, patterns []string) bool {
+	mime := mimeS(de.FileType())
+	for _, pattern := range patterns {
+		if ok, _ := filepath.Match(pattern, mime); ok {
+			return true
+		}
+	}
+	return false
+}
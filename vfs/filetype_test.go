@@ -0,0 +1,24 @@
+package vfs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileTypeOfDir(t *testing.T) {
+	assert := assert.New(t)
+
+	root := t.TempDir()
+	dpath := filepath.Join(root, "sub")
+	assert.NoError(os.Mkdir(dpath, 0755))
+
+	git := NewGitStatus(root)
+	d, err := NewDir(dpath, root, git, NewVFSOption())
+	assert.NoError(err)
+	assert.Equal("inode/directory", d.FileType().Mime)
+	assert.Equal("inode/directory", d.Field(ViewFieldMimeType))
+	assert.Equal("directory", d.Field(ViewFieldFileType))
+}
@@ -107,26 +107,27 @@ func (s GitStatusCode) String() string {
 }
 
 func (s GitStatusCode) Color() *Color {
-	if c, ok := cgitmap[s]; !ok {
+	if key, ok := cgitmap[s]; !ok {
 		return paw.Cdashp
 	} else {
-		return c
+		return paw.NewEXAColor(key)
 	}
 }
 
-var cgitmap = map[GitStatusCode]*Color{
-	GitNo:                 paw.Cdashp,
-	GitUnmodified:         paw.Cdashp,
-	GitUntracked:          paw.NewEXAColor("gm"),
-	GitModified:           paw.NewEXAColor("gm"),
-	GitAdded:              paw.NewEXAColor("ga"),
-	GitDeleted:            paw.NewEXAColor("gd"),
-	GitRenamed:            paw.NewEXAColor("gv"),
-	GitCopied:             paw.NewEXAColor("gv"),
-	GitUpdatedButUnmerged: paw.NewEXAColor("gt"),
-	GitIgnored:            paw.Cdashp,
-	GitChanged:            paw.NewEXAColor("ga"),
-	GitUnChanged:          paw.Cdashp,
+// cgitmap is the keys of EXAColorAttributes of GitStatusCode, looked up when coloring so that themes apply
+var cgitmap = map[GitStatusCode]string{
+	GitNo:                 "-",
+	GitUnmodified:         "-",
+	GitUntracked:          "gm",
+	GitModified:           "gm",
+	GitAdded:              "ga",
+	GitDeleted:            "gd",
+	GitRenamed:            "gv",
+	GitCopied:             "gv",
+	GitUpdatedButUnmerged: "gt",
+	GitIgnored:            "-",
+	GitChanged:            "ga",
+	GitUnChanged:          "-",
 }
 
 // GitStatus stores git status of `Branch`
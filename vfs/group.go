@@ -1,6 +1,15 @@
 package vfs
 
-import "github.com/shyang107/paw"
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/shyang107/paw"
+)
 
 type Group int
 
@@ -14,19 +23,125 @@ const (
 	// GroupedR use in returned values of Dir.ReadDir(n). Arrange reverse order of []DirEntryX by file..., dir...
 	// 	see example/vfs
 	GroupedR
+	// GroupByExtension partitions []DirEntryX into sections by the extension of name, directories first
+	GroupByExtension
+	// GroupByType partitions []DirEntryX into sections by the detected MIME type, directories first
+	GroupByType
+	// GroupByOwner partitions []DirEntryX into sections by the owner
+	GroupByOwner
+	// GroupByAge partitions []DirEntryX into sections by the modified time: today, this week (the last 7 days) and older
+	GroupByAge
+	// GroupByGit partitions []DirEntryX into sections by git status: unmerged, changed, untracked, unmodified and ignored
+	GroupByGit
+)
+
+var (
+	// GroupNames are the names of sections of grouping (see ParseGroup)
+	GroupNames = map[string]Group{
+		"none":  GroupNone,
+		"dirs":  Grouped,
+		"files": GroupedR,
+		"ext":   GroupByExtension,
+		"type":  GroupByType,
+		"owner": GroupByOwner,
+		"age":   GroupByAge,
+		"git":   GroupByGit,
+	}
+	groupStrings = []string{"Not grouped", "Grouped", "Grouped reversely",
+		"Grouped by extension", "Grouped by type", "Grouped by owner", "Grouped by age", "Grouped by git"}
 )
 
 func (g Group) String() string {
-	return []string{"Not grouped", "Grouped", "Grouped reversely"}[g-1]
+	if !g.isValid() {
+		return "Unknown grouping"
+	}
+	return groupStrings[g-1]
+}
+
+func (g Group) isValid() bool {
+	return g >= GroupNone && g <= GroupByGit
+}
+
+// ParseGroup returns the grouping of `name`, one of the keys of GroupNames (case insensitive)
+func ParseGroup(name string) (Group, error) {
+	g, ok := GroupNames[strings.ToLower(name)]
+	if !ok {
+		return GroupNone, fmt.Errorf("unknown grouping %q", name)
+	}
+	return g, nil
+}
+
+// IsSection returns true if g partitions entries into headed sections
+func (g Group) IsSection() bool {
+	return g >= GroupByExtension && g <= GroupByGit
+}
+
+// SectionOf returns the rank and the name of section of de in grouping g; the sections are ordered by rank and then by name.
+func (g Group) SectionOf(de DirEntryX) (rank int, name string) {
+	switch g {
+	case GroupByExtension:
+		if de.IsDir() {
+			return 0, "directories"
+		}
+		ext := strings.ToLower(filepath.Ext(de.Name()))
+		if len(ext) == 0 {
+			return 2, "no extension"
+		}
+		return 1, ext
+	case GroupByType:
+		if de.IsDir() {
+			return 0, "directories"
+		}
+		return 1, mimeS(de.FileType())
+	case GroupByOwner:
+		return 0, de.User()
+	case GroupByAge:
+		var (
+			now   = time.Now()
+			today = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+			mt    = de.ModifiedTime()
+		)
+		switch {
+		case !mt.Before(today):
+			return 0, "today"
+		case !mt.Before(today.AddDate(0, 0, -6)):
+			return 1, "this week"
+		}
+		return 2, "older"
+	case GroupByGit:
+		rank = gitSortRank(de.XY())
+		return rank, []string{"unmerged", "changed", "untracked", "unmodified", "ignored"}[rank]
+	}
+	return 0, ""
+}
+
+// SortSections sorts dxs stably by the sections of g, so that the order of entries in a section is kept
+func (g Group) SortSections(dxs []DirEntryX) {
+	if !g.IsSection() {
+		return
+	}
+	type key struct {
+		rank int
+		name string
+	}
+	keys := make(map[DirEntryX]key, len(dxs))
+	for _, de := range dxs {
+		rank, name := g.SectionOf(de)
+		keys[de] = key{rank, name}
+	}
+	sort.SliceStable(dxs, func(i, j int) bool {
+		ki, kj := keys[dxs[i]], keys[dxs[j]]
+		if ki.rank != kj.rank {
+			return ki.rank < kj.rank
+		}
+		return ki.name < kj.name
+	})
 }
 
 // IsOk returns true for effective and otherwise not. In genernal, use it in checking.
 func (g Group) IsOk() bool {
 	paw.Logger.Debug("checking Group..." + paw.Caller(1))
-	if g > 0 && g < 4 {
-		return true
-	}
-	return false
+	return g.isValid()
 	// switch g {
 	// case Grouped, GroupedR, GroupNone:
 	// 	return true
@@ -34,3 +149,55 @@ func (g Group) IsOk() bool {
 	// 	return false
 	// }
 }
+
+// section prints the heads and the subtotals of sections of entries of a directory in the grouping by sections (see Group.IsSection)
+type section struct {
+	w      io.Writer
+	g      Group
+	pad    string
+	wdstty int
+	// flush is called before printing the subtotal of a section, e.g. to render the rows of section
+	flush  func()
+	name   string
+	count  int
+	nd, nf int
+	size   int64
+}
+
+func newSection(w io.Writer, g Group, pad string, wdstty int) *section {
+	return &section{w: w, g: g, pad: pad, wdstty: wdstty}
+}
+
+// add prints the subtotal of the current section and the head of next section if `de` begins a new one, and then counts de in the section
+func (s *section) add(de DirEntryX) {
+	if !s.g.IsSection() {
+		return
+	}
+	_, name := s.g.SectionOf(de)
+	if s.count == 0 || name != s.name {
+		s.end()
+		s.name = name
+		fmt.Fprintf(s.w, "%s%s%s\n", s.pad, paw.Cdashp.Sprint("» "), paw.Cfield.Sprint(name))
+	}
+	s.count++
+	if de.IsDir() {
+		s.nd++
+	} else {
+		s.nf++
+		if de.Mode().IsRegular() {
+			s.size += de.Size()
+		}
+	}
+}
+
+// end prints the subtotal of the current section
+func (s *section) end() {
+	if s.count == 0 {
+		return
+	}
+	if s.flush != nil {
+		s.flush()
+	}
+	fmt.Fprintln(s.w, dirSummary(s.pad+"  ", s.nd, s.nf, s.size, s.wdstty))
+	s.count, s.nd, s.nf, s.size = 0, 0, 0, 0
+}
@@ -3,6 +3,7 @@ package vfs
 import (
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
 	"os/user"
 	"path/filepath"
@@ -79,22 +80,25 @@ func PathTo(de DirEntryX, opt *PathToOption) (p string) {
 		if opt.IsColor {
 			p = nameCbg(de, opt.Bgc)
 		} else {
-			p = de.Name()
+			p = quote(de, de.Name())
 		}
 	case PRTNameToLink:
 		if opt.IsColor {
 			p = nameToLinkCbg(de, opt.Bgc)
 		} else {
-			p = de.NameToLink()
+			p = quote(de, de.Name())
+			if de.IsLink() {
+				p += " -> " + quote(de, de.LinkPath())
+			}
 		}
 	case PRTLink:
 		if opt.IsColor {
 			p = linkCbg(de, opt.Bgc)
 		} else {
-			p = de.LinkPath()
+			p = quote(de, de.LinkPath())
 		}
 	case PRTPath:
-		_, _, p = pathC(de.Path(), opt.Bgc)
+		_, _, p = pathC(de.Path(), quotingOf(de), opt.Bgc)
 		if !opt.IsColor {
 			p = paw.StripANSI(p)
 		}
@@ -104,7 +108,7 @@ func PathTo(de DirEntryX, opt *PathToOption) (p string) {
 			p = paw.StripANSI(p)
 		}
 	case PRTRelPath:
-		p = relPathC(de.RelPath(), opt.Bgc)
+		p = relPathC(de.RelPath(), quotingOf(de), opt.Bgc)
 		if !opt.IsColor {
 			p = paw.StripANSI(p)
 		}
@@ -128,7 +132,7 @@ func PathTo(de DirEntryX, opt *PathToOption) (p string) {
 				cdir := "" // cdirp.Sprint("./")
 				dir := filepath.Dir(de.RelPath())
 				if dir != "." {
-					cdir = relPathC(dir, opt.Bgc) + cdirp.Sprint("/")
+					cdir = relPathC(dir, quotingOf(de), opt.Bgc) + cdirp.Sprint("/")
 				}
 				crp = cdir + nameCbg(de, opt.Bgc)
 			}
@@ -141,13 +145,13 @@ func PathTo(de DirEntryX, opt *PathToOption) (p string) {
 				clink = linkCbg(de, opt.Bgc)
 			}
 		} else {
-			crp = de.RelPath()
+			crp = quote(de, de.RelPath())
 			if de.RelPath() != "." {
 				crp = "./" + crp
 			}
 			if de.IsLink() {
 				carrow = " -> "
-				clink = de.LinkPath()
+				clink = quote(de, de.LinkPath())
 			}
 		}
 		p = crp + carrow + clink
@@ -175,8 +179,8 @@ func choiceSprintf(opt *PathToOption) (dsprintf, nsprintf func(string, ...interf
 	return dsprintf, nsprintf
 }
 
-// pathC return color string of path
-func pathC(path string, bgc []Attribute) (cdir, cname, cpath string) {
+// pathC return color string of path; the path needing quoting in style q is quoted as a whole and returned as cname
+func pathC(path string, q QuotingStyle, bgc []Attribute) (cdir, cname, cpath string) {
 	var (
 		cdirp = paw.CloneColor(paw.Cdirp)
 		cdip  = paw.CloneColor(paw.Cdip)
@@ -186,6 +190,10 @@ func pathC(path string, bgc []Attribute) (cdir, cname, cpath string) {
 		cdirp = cdirp.Add(bgc...)
 		cdip = cdip.Add(bgc...)
 	}
+	if qpath := q.Quote(path); qpath != path {
+		cname = cdip.Sprint(qpath)
+		return "", cname, cname
+	}
 	cdir, cname = filepath.Split(path)
 	if len(cname) > 0 {
 		cdir = cdirp.Sprint(cdir)
@@ -198,14 +206,19 @@ func pathC(path string, bgc []Attribute) (cdir, cname, cpath string) {
 }
 
 func nameC(d DirEntryX) string {
-	return d.LSColor().Sprint(d.Name())
+	return iconC(d) + hyperlinkC(d, d.LSColor().Sprint(quote(d, d.Name())))
 }
 func nameCbg(de DirEntryX, bgc []Attribute) string {
 	c := paw.CloneColor(de.LSColor())
 	if bgc != nil {
 		c = c.Add(bgc...)
 	}
-	return c.Sprint(de.Name())
+	return hyperlinkC(de, c.Sprint(quote(de, de.Name())))
+}
+
+// hyperlinkC returns the colorful name, cname, of de wrapped in the hyperlink to de if hyperlinks are enabled (see paw.EnableHyperlink)
+func hyperlinkC(de DirEntryX, cname string) string {
+	return paw.HyperlinkPath(de.Path(), cname)
 }
 
 func linkC(de DirEntryX) string {
@@ -216,9 +229,11 @@ func linkC(de DirEntryX) string {
 		if filepath.IsAbs(link) { // get rel path from absolute path
 			link, _ = filepath.Rel(dir, alink)
 		}
+		if qlink := quote(de, link); qlink != link {
+			return paw.FileLSColor(alink).Sprint(qlink)
+		}
 		dir, name := filepath.Split(link)
-		if _, err := os.Stat(alink); os.IsNotExist(err) {
-			fmt.Println(err)
+		if isBrokenLink(de) {
 			return paw.Cdirp.Sprint(dir) + paw.Corp.Sprint(name)
 		}
 		return paw.Cdirp.Sprint(dir) + paw.FileLSColor(alink).Sprint(name)
@@ -249,9 +264,15 @@ func linkCbg(de DirEntryX, bgc []Attribute) string {
 		// else {
 		// 	alink = filepath.Join(dir, alink)
 		// }
+		if qlink := quote(de, link); qlink != link {
+			c = paw.FileLSColor(alink)
+			if bgc != nil {
+				c = c.Add(bgc...)
+			}
+			return c.Sprint(qlink)
+		}
 		dir, name := filepath.Split(link)
-		if _, err := os.Stat(alink); os.IsNotExist(err) {
-			fmt.Println(err)
+		if isBrokenLink(de) {
 			return cdirp.Sprint(dir) + corp.Sprint(name)
 		}
 		c = paw.FileLSColor(alink)
@@ -283,6 +304,17 @@ func nameToLinkCbg(de DirEntryX, bgc []Attribute) string {
 	}
 }
 
+// isBrokenLink reports whether de is a symbolic link pointing to a non-existent file (orphan)
+func isBrokenLink(de DirEntryX) bool {
+	switch f := de.(type) {
+	case *File:
+		return f.isBroken
+	case *Dir:
+		return f.isBroken
+	}
+	return false
+}
+
 func getLinkPath(path string) string {
 	alink, err := os.Readlink(path)
 	if err != nil {
@@ -305,9 +337,12 @@ func pathToLinkC(de DirEntryX, bgc []Attribute) (cpath string) {
 	}
 
 	dir, name := filepath.Split(de.Path())
-	cpath = cdirp.Sprint(dir) + cnamep.Sprint(name)
+	if qpath := quote(de, de.Path()); qpath != de.Path() {
+		dir, name = "", qpath
+	}
+	cpath = cdirp.Sprint(dir) + hyperlinkC(de, cnamep.Sprint(name))
 	if de.IsLink() {
-		_, _, lpath := pathC(de.LinkPath(), bgc)
+		_, _, lpath := pathC(de.LinkPath(), quotingOf(de), bgc)
 		cpath += cdashp.Sprint(" -> ") + lpath
 	}
 	return cpath
@@ -390,7 +425,8 @@ func _sizeC(de DirEntryX) (csize string) {
 	nss := len(ss)
 	sn := fmt.Sprintf("%s", ss[:nss-1])
 	su := ss[nss-1:]
-	csize = paw.Csnp.Sprint(sn) + paw.Csup.Sprint(su)
+	cn, cu := paw.SizeUnitColors(su)
+	csize = cn.Sprint(sn) + cu.Sprint(su)
 	return csize
 }
 
@@ -429,18 +465,69 @@ func alXYC(d DirEntryX) string {
 	return " " + d.Git().XYC(d.RelPath())
 }
 
+// iconS returns the icon of d by the name of directory, or by the name and extension of file (see paw.DirIcon and paw.FileIcon)
+func iconS(d DirEntryX) string {
+	switch {
+	case d.IsLink():
+		if d.IsDir() {
+			return paw.IconLinkDir
+		}
+		return paw.IconLink
+	case d.IsDir():
+		return paw.DirIcon(d.Name())
+	}
+	icon := paw.FileIcon(d.Name())
+	if icon == paw.IconFile && d.IsExecutable() {
+		return paw.IconExec
+	}
+	return icon
+}
+
+// hasIcon reports whether the icon is shown before the name of d (VFSOption.IsIcon)
+func hasIcon(d DirEntryX) bool {
+	opt := optionOf(d)
+	return opt != nil && opt.IsIcon
+}
+
+// iconPrefix returns the icon of d followed by a space if hasIcon(d), otherwise ""
+func iconPrefix(d DirEntryX) string {
+	if !hasIcon(d) {
+		return ""
+	}
+	return iconS(d) + " "
+}
+
+// iconC returns the iconPrefix of d, the icon is colored the same as its name
+func iconC(d DirEntryX) string {
+	if !hasIcon(d) {
+		return ""
+	}
+	return d.LSColor().Sprint(iconS(d)) + " "
+}
+
+// optionOf returns the VFSOption of d, or nil if d is not created with an option
+func optionOf(d DirEntryX) *VFSOption {
+	switch f := d.(type) {
+	case *File:
+		return f.opt
+	case *Dir:
+		return f.opt
+	}
+	return nil
+}
+
 func alNameC(d DirEntryX) string {
 	var cname string
 	if d.IsDir() {
-		cname = paw.Cdip.Sprint(d.Name())
+		cname = hyperlinkC(d, paw.Cdip.Sprint(quote(d, d.Name())))
 	} else {
 		if d.IsLink() {
 			cname = PathTo(d, &PathToOption{true, nil, PRTNameToLink})
 		} else {
-			cname = d.LSColor().Sprint(d.Name())
+			cname = hyperlinkC(d, d.LSColor().Sprint(quote(d, d.Name())))
 		}
 	}
-	return ViewFieldName.AlignedSC(cname)
+	return ViewFieldName.AlignedSC(iconC(d) + cname)
 }
 
 func alFieldC(d DirEntryX, fd ViewField) string {
@@ -453,7 +540,9 @@ func permissionS(de DirEntryX) string {
 	if de.Xattibutes() == nil {
 		sperm += "?"
 	} else {
-		if len(de.Xattibutes()) > 0 {
+		if de.HasACL() {
+			sperm += ACLSymbol
+		} else if len(de.Xattibutes()) > 0 {
 			sperm += "@"
 		} else {
 			sperm += " "
@@ -558,52 +647,40 @@ func deDateS(d DirEntryX, fd ViewField) (sdate string) {
 }
 
 func GetDexLSColor(de DirEntryX) *Color {
-	if de.IsDir() {
-		return paw.Cdip
-	}
-
-	if de.IsLink() { // os.ModeSymlink
-		_, err := os.Readlink(de.Path())
-		if err != nil {
-			return paw.NewLSColor("or")
+	var (
+		mode     = de.Mode()
+		isOrphan bool
+		hasCap   bool
+	)
+	switch {
+	case de.IsLink(): // os.ModeSymlink
+		isOrphan = isBrokenLink(de)
+		if !isOrphan && paw.LSColorLinkTarget {
+			if target, err := filepath.EvalSymlinks(de.Path()); err == nil {
+				return paw.FileLSColor(target)
+			}
 		}
-		return paw.Clnp
-	}
-
-	if de.IsCharDev() { // os.ModeDevice | os.ModeCharDevice
-		return paw.Ccdp
-	}
-
-	if de.IsDev() { //
-		return paw.Cbdp
-	}
-
-	if de.IsFIFO() { //os.ModeNamedPipe
-		return paw.Cpip
-	}
-	if de.IsSocket() { //os.ModeSocket
-		return paw.Csop
+		// the mode of link is got by os.Stat (the mode of its target), so the kind is "ln" (or "or") like as ls
+		mode |= fs.ModeSymlink
+	case de.IsDir():
+		mode = mode&^fs.ModeSymlink | fs.ModeDir
+	default:
+		hasCap = paw.IndexOfString(de.XattrNames(), "security.capability") >= 0
 	}
 
-	if de.IsExecutable() && !de.IsDir() {
-		return paw.Cexp
+	// kinds of file, e.g. di, tw, ln, or, su, ca, ex
+	if kind := paw.LSColorKindOf(mode, isOrphan, hasCap); len(kind) > 0 {
+		return paw.LSKindColor(kind)
 	}
 
 	name := de.Name()
-	if att, ok := paw.LSColorAttributes[name]; ok {
-		return color.New(att...)
+	if att, ok := paw.NameLSColorAttributes(name); ok {
+		return paw.NewColor(att...)
 	}
-	ext := filepath.Ext(name)
-	if att, ok := paw.LSColorAttributes[ext]; ok {
-		return color.New(att...)
-	}
-	file := strings.TrimSuffix(name, ext)
-	if att, ok := paw.LSColorAttributes[file]; ok {
-		return color.New(att...)
-	}
-	for re, att := range paw.ReExtLSColors {
-		if re.MatchString(name) {
-			return color.New(att...)
+	// extensionless file, e.g. binaries and scripts, is colored by its content
+	if len(filepath.Ext(name)) == 0 && de.Type().IsRegular() {
+		if c := paw.FileTypeLSColor(de.FileType()); c != nil {
+			return c
 		}
 	}
 
@@ -622,6 +699,11 @@ func checkFieldsHasGit(fields []ViewField, isNoGit bool) []ViewField {
 }
 
 func dirSummary(pad string, ndirs int, nfiles int, sumsize int64, wdstty int) string {
+	return dirTextSummary(pad, ndirs, nfiles, sumsize, nil, wdstty)
+}
+
+// dirTextSummary is dirSummary with the totals of text files, tt, if it is not nil
+func dirTextSummary(pad string, ndirs int, nfiles int, sumsize int64, tt *textTotal, wdstty int) string {
 	var (
 		ss  = bytefmt.ByteSize(sumsize)
 		nss = len(ss)
@@ -640,6 +722,7 @@ func dirSummary(pad string, ndirs int, nfiles int, sumsize int64, wdstty int) st
 		cnitems +
 		paw.Cpmpt.Sprint(" objects), size ≈ ") +
 		csumsize +
+		tt.summaryC() +
 		paw.Cpmpt.Sprint(". ")
 	nmsg := paw.StringWidth(paw.StripANSI(msg))
 	msg += paw.Cpmpt.Sprint(paw.Spaces(wdstty + 1 - nmsg))
@@ -657,6 +740,11 @@ func FprintDirSummaryNoColor(w io.Writer, pad string, ndirs int, nfiles int, sum
 }
 
 func totalSummary(pad string, ndirs int, nfiles int, sumsize int64, wdstty int) string {
+	return totalTextSummary(pad, ndirs, nfiles, sumsize, nil, wdstty)
+}
+
+// totalTextSummary is totalSummary with the totals of text files, tt, if it is not nil
+func totalTextSummary(pad string, ndirs int, nfiles int, sumsize int64, tt *textTotal, wdstty int) string {
 	var (
 		ss  = bytefmt.ByteSize(sumsize)
 		nss = len(ss)
@@ -676,6 +764,7 @@ func totalSummary(pad string, ndirs int, nfiles int, sumsize int64, wdstty int)
 		cnitems +
 		paw.Cpmpt.Sprint(" objects), total size ≈ ") +
 		csumsize +
+		tt.summaryC() +
 		paw.Cpmpt.Sprint(".")
 	nsummary := paw.StringWidth(paw.StripANSI(summary))
 	summary += paw.Cpmpt.Sprint(paw.Spaces(wdstty + 1 - nsummary))
@@ -729,12 +818,12 @@ func getRelPath(pad, slevel, rp string, isBg bool) string {
 		cdip = cdip.Add(bgc...)
 		clevelp = clevelp.Add(bgc...)
 	}
-	cdir, cname, cpath := pathC(rp, bgc)
+	cdir, cname, cpath := pathC(rp, QuotingLiteral, bgc)
 	cpath = cdirp.Sprint("./") + cdir + cname
 	clevel := clevelp.Sprintf("%s", slevel)
 	return fmt.Sprintf("%s%s%v", pad, clevel, cpath)
 }
-func relPathC(rp string, bgc []Attribute) string {
+func relPathC(rp string, q QuotingStyle, bgc []Attribute) string {
 	var (
 		cdirp   = paw.CloneColor(paw.Cdirp)
 		cdip    = paw.CloneColor(paw.Cdip)
@@ -747,7 +836,7 @@ func relPathC(rp string, bgc []Attribute) string {
 		cdip = cdip.Add(bgc...)
 		clevelp = clevelp.Add(bgc...)
 	}
-	cdir, cname, cpath := pathC(rp, bgc)
+	cdir, cname, cpath := pathC(rp, q, bgc)
 	cpath = cdirp.Sprint("./") + cdir + cname
 	return fmt.Sprintf("%v", cpath)
 }
@@ -780,6 +869,20 @@ func FprintXattrs(w io.Writer, wdpad int, xattrs []string) {
 	}
 }
 
+// FprintACL prints out the full ACL entries with padding wdpad
+func FprintACL(w io.Writer, wdpad int, acl []string) {
+	if len(acl) < 1 {
+		return
+	}
+	sp := paw.Spaces(wdpad)
+	for _, a := range acl {
+		fmt.Fprintf(w, "%s%v%v\n",
+			sp,
+			paw.Cxbp.Sprint(" "+ACLSymbol+" "),
+			paw.Cxap.Sprint(a))
+	}
+}
+
 func GetViewFieldWithoutName(vfields ViewField, de DirEntryX) (meta string, wdmeta int) {
 	meta, wdmeta = GetViewFieldWithoutNameA(vfields.Fields(), de)
 	return meta, wdmeta
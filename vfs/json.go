@@ -0,0 +1,79 @@
+package vfs
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pkg/xattr"
+)
+
+// jsonEntry is an entry of the JSON snapshot of VFS
+type jsonEntry struct {
+	Path     string       `json:"path"`
+	Name     string       `json:"name"`
+	Mode     string       `json:"mode"`
+	Size     int64        `json:"size"`
+	Modified time.Time    `json:"modified"`
+	Link     string       `json:"link,omitempty"`
+	Xattrs   []jsonXattr  `json:"xattrs,omitempty"`
+	Children []*jsonEntry `json:"children,omitempty"`
+}
+
+// jsonXattr is an extended attribute of jsonEntry. Text value is kept as text, and binary value is hex-encoded with Binary = true.
+type jsonXattr struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Binary bool   `json:"binary,omitempty"`
+}
+
+// DumpJSON writes the snapshot of the built VFS to w as indented JSON, including the names and the full values of extended attributes of all entries.
+func (v *VFS) DumpJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(newJSONEntry(v.RootDir()))
+}
+
+func newJSONEntry(de DirEntryX) *jsonEntry {
+	je := &jsonEntry{
+		Path:     de.RelPath(),
+		Name:     de.Name(),
+		Mode:     de.Mode().String(),
+		Size:     de.Size(),
+		Modified: de.ModTime(),
+		Xattrs:   jsonXattrs(de),
+	}
+	if de.IsLink() {
+		je.Link = de.LinkPath()
+	}
+	if d, ok := de.(*Dir); ok {
+		des, _ := d.ReadDirAll()
+		for _, child := range des {
+			je.Children = append(je.Children, newJSONEntry(child))
+		}
+	}
+	return je
+}
+
+func jsonXattrs(de DirEntryX) []jsonXattr {
+	names := de.XattrNames()
+	if len(names) == 0 {
+		return nil
+	}
+	xattrs := make([]jsonXattr, 0, len(names))
+	for _, name := range names {
+		value, err := xattr.Get(de.Path(), name)
+		if err != nil {
+			xattrs = append(xattrs, jsonXattr{Name: name})
+			continue
+		}
+		if len(value) == 0 || isXattrText(value) {
+			xattrs = append(xattrs, jsonXattr{Name: name, Value: strings.TrimRight(string(value), "\x00")})
+		} else {
+			xattrs = append(xattrs, jsonXattr{Name: name, Value: hex.EncodeToString(value), Binary: true})
+		}
+	}
+	return xattrs
+}
@@ -0,0 +1,49 @@
+package vfs
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/xattr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDumpJSONXattrs(t *testing.T) {
+	assert := assert.New(t)
+
+	root := t.TempDir()
+	path := filepath.Join(root, "a.txt")
+	if err := os.WriteFile(path, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := xattr.Set(path, "user.tag", []byte("release")); err != nil {
+		t.Skipf("extended attributes are not supported: %v", err)
+	}
+	if err := xattr.Set(path, "user.bin", []byte{0, 0xff, 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	opt := NewVFSOption()
+	v, err := NewVFS(root, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := v.BuildFS(); err != nil {
+		t.Fatal(err)
+	}
+	buf := new(bytes.Buffer)
+	assert.NoError(v.DumpJSON(buf))
+
+	var je jsonEntry
+	assert.NoError(json.Unmarshal(buf.Bytes(), &je))
+	if assert.Len(je.Children, 1) {
+		assert.Equal("a.txt", je.Children[0].Path)
+		assert.ElementsMatch([]jsonXattr{
+			{Name: "user.tag", Value: "release"},
+			{Name: "user.bin", Value: "00ff01", Binary: true},
+		}, je.Children[0].Xattrs)
+	}
+}
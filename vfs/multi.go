@@ -0,0 +1,201 @@
+package vfs
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/shyang107/paw"
+	"github.com/sirupsen/logrus"
+)
+
+// MultiVFS is a set of VFS of several roots (e.g. sibling checkouts) built in one go; it is viewed by a tree per root, or merged into one table with the column of roots, and followed by the totals of all roots.
+type MultiVFS struct {
+	roots  []*VFS
+	opt    *VFSOption
+	errors []error
+	// IsMerged merges the entries of all roots into one table with the column of roots, instead of viewing every root by VFSOption.ViewType
+	IsMerged bool
+}
+
+// NewMultiVFS creates the VFS of every root of `roots`, each of them uses a copy of `opt`; the roots that can not be created are skipped and reported by Errors. It returns error only if there is no valid root.
+func NewMultiVFS(roots []string, opt *VFSOption) (*MultiVFS, error) {
+	m := &MultiVFS{
+		roots:  make([]*VFS, 0, len(roots)),
+		opt:    opt,
+		errors: []error{},
+	}
+	for _, root := range roots {
+		ropt := *opt
+		v, err := NewVFS(root, &ropt)
+		if err != nil {
+			m.errors = append(m.errors, err)
+			continue
+		}
+		m.roots = append(m.roots, v)
+	}
+	if len(m.roots) == 0 {
+		return nil, errors.New("NewMultiVFS: no valid root directory")
+	}
+	return m, nil
+}
+
+// Roots returns the VFS of roots
+func (m *MultiVFS) Roots() []*VFS {
+	return m.roots
+}
+
+// Errors returns the errors of roots that can not be created or built
+func (m *MultiVFS) Errors() []error {
+	return m.errors
+}
+
+func (m *MultiVFS) Option() *VFSOption {
+	return m.opt
+}
+
+// BuildFS builds the VFS of every root; the roots failed to build are removed and reported by Errors.
+func (m *MultiVFS) BuildFS() error {
+	roots := make([]*VFS, 0, len(m.roots))
+	for _, v := range m.roots {
+		if err := v.BuildFS(); err != nil {
+			m.errors = append(m.errors, err)
+			continue
+		}
+		roots = append(roots, v)
+	}
+	m.roots = roots
+	if len(m.roots) == 0 {
+		return errors.New("BuildFS: no valid root directory")
+	}
+	return nil
+}
+
+// NItems returns the numbers of dirs and files of all roots
+func (m *MultiVFS) NItems() (ndirs, nfiles, nitems int) {
+	for _, v := range m.roots {
+		nd, nf, _ := v.RootDir().NItems(true)
+		ndirs += nd
+		nfiles += nf
+	}
+	return ndirs, nfiles, ndirs + nfiles
+}
+
+// TotalSize returns the total size of all roots
+func (m *MultiVFS) TotalSize() int64 {
+	var size int64
+	for _, v := range m.roots {
+		size += v.RootDir().TotalSize()
+	}
+	return size
+}
+
+// rootTag returns the tag of i-th root, e.g. "{R1}"
+func rootTag(i int) string {
+	return fmt.Sprintf("{R%d}", i+1)
+}
+
+// View views every root by its VFSOption.ViewType with the tag of root (e.g. "{R1}"), or merges them into one table if m.IsMerged, and then the totals of all roots.
+func (m *MultiVFS) View(w io.Writer) {
+	paw.Logger.WithFields(logrus.Fields{
+		"roots":    len(m.roots),
+		"IsMerged": m.IsMerged,
+	}).Debug("view...")
+
+	w = paw.NewColorWriter(w, m.opt.ColorMode)
+	wdstty := sttyWidth - 2
+
+	for i, v := range m.roots {
+		fmt.Fprintf(w, "%s %s\n",
+			paw.ChoseColor(i).Sprint(rootTag(i)+":"),
+			PathTo(v.RootDir(), &PathToOption{true, nil, PRTPathToLink}))
+	}
+	for _, err := range m.errors {
+		fmt.Fprintln(w, paw.Cerror.Sprint(err))
+	}
+
+	if m.IsMerged {
+		m.viewMerged(w)
+	} else {
+		for i, v := range m.roots {
+			FprintBanner(w, "", "=", wdstty)
+			fmt.Fprintln(w, paw.ChoseColor(i).Sprint(rootTag(i)))
+			v.View(w)
+		}
+	}
+
+	nd, nf, _ := m.NItems()
+	FprintBanner(w, "", "=", wdstty)
+	FprintTotalSummary(w, paw.Cpmpt.Sprint("For all roots, "), nd, nf, m.TotalSize(), wdstty)
+}
+
+// viewMerged views the entries of all roots in one table with the column of roots; the names of entries in sub-directories are prefixed by the relative path.
+func (m *MultiVFS) viewMerged(w io.Writer) {
+	var (
+		wdstty  = sttyWidth - 2
+		vfields = m.opt.ViewFields &^ ViewFieldNo
+		noGit   = true
+		wdroot  = len(rootTag(len(m.roots) - 1))
+	)
+	defer ViewFieldName.SetWidth(ViewFieldName.Width())
+
+	for _, v := range m.roots {
+		if !v.git.NoGit {
+			noGit = false
+		}
+	}
+	vfields = vfields.RemoveGit(noGit)
+	for _, v := range m.roots {
+		vfields.ModifyWidths(v.RootDir())
+	}
+	wdname := ViewFieldName.Width() - wdroot - 1
+	ViewFieldName.SetWidth(wdname)
+
+	FprintBanner(w, "", "=", wdstty)
+	fmt.Fprintf(w, "%s %v\n",
+		paw.Chdp.Sprint(paw.AlignWithWidth(paw.AlignLeft, "Root", wdroot)),
+		vfields.GetHead(paw.Chdp))
+
+	hasX, isViewNoDirs, isViewNoFiles := m.roots[0].hasX_NoDir_NoFiles()
+	for i, v := range m.roots {
+		var (
+			rootdir   = v.RootDir()
+			tag       = paw.ChoseColor(i).Sprint(paw.AlignWithWidth(paw.AlignLeft, rootTag(i), wdroot))
+			nitems    int
+			nd, nf    int
+			size      int64
+			wdxrowpad = wdroot + 1
+		)
+		for _, rp := range rootdir.relpaths {
+			if rootdir.opt.IsRelPathNotView(rp) {
+				continue
+			}
+			cur, err := rootdir.getDir(rp)
+			if err != nil {
+				paw.Logger.WithFields(logrus.Fields{"rp": rp}).Error(err)
+				continue
+			}
+			des, _ := cur.ReadDirAll()
+			prefix := ""
+			ViewFieldName.SetWidth(wdname)
+			if rp != "." {
+				prefix = paw.Cdirp.Sprint(rp + "/")
+				ViewFieldName.SetWidth(wdname - paw.StringWidth(rp+"/"))
+			}
+			for _, de := range des {
+				if isSkipViewItem(de, isViewNoDirs, isViewNoFiles, &nitems, &nd, &nf, &size) {
+					continue
+				}
+				fmt.Fprintf(w, "%s %v%s%v\n", tag, vfields.RowStringXNameC(de), prefix, de.FieldC(ViewFieldName))
+				if hasX {
+					for _, row := range vfields.XattibutesRowsSC(de, rootdir.opt.IsXattrValue) {
+						fmt.Fprintf(w, "%s%s\n", paw.Spaces(wdxrowpad), row)
+					}
+				}
+			}
+			if rootdir.opt.Depth == 0 {
+				break
+			}
+		}
+	}
+}
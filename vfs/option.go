@@ -13,11 +13,27 @@ type VFSOption struct {
 	// ScanDepth *ScanDepth
 	Depth          int
 	IsForceRecurse bool
+	Follow         FollowMode
 	Grouping       Group
 	ByField        SortKey
 	Skips          *SkipConds
 	ViewFields     ViewField
 	ViewType       ViewType
+	IsXattrValue   bool
+	// SortKeys are the keys of multi-key sorting, which override ByField if not empty
+	SortKeys SortKeys
+	// GridField is the field shown before name in each cell of grid view (e.g. ViewFieldSize or ViewFieldGit), or 0 for none.
+	GridField ViewField
+	// IsGridAcross lists entries by rows instead of by columns in grid view (like as `ls -x`)
+	IsGridAcross bool
+	// ViewName is the name of view registered by RegisterView, it overrides ViewType if it is not empty.
+	ViewName string
+	// IsIcon shows the icon (Nerd Font glyph) of file type before names (see paw.FileIcon and paw.DirIcon)
+	IsIcon bool
+	// ColorMode decides whether the output of View is colored per writer (see paw.NewColorWriter)
+	ColorMode paw.ColorMode
+	// Quoting is the style of quoting names in views
+	Quoting QuotingStyle
 }
 
 // NewVFSOption creates a new instance of VFSOption
@@ -25,11 +41,19 @@ func NewVFSOption() *VFSOption {
 	return &VFSOption{
 		Depth:          0,
 		IsForceRecurse: false,
+		Follow:         FollowCommandLine,
 		Grouping:       GroupNone,
 		ByField:        SortByLowerName,
 		Skips:          NewSkipConds().Add(DefaultSkiper),
 		ViewFields:     DefaultViewField,
 		ViewType:       ViewList,
+		IsXattrValue:   false,
+		GridField:      0,
+		IsGridAcross:   false,
+		ViewName:       "",
+		IsIcon:         false,
+		ColorMode:      paw.ColorAuto,
+		Quoting:        QuotingLiteral,
 	}
 }
 
@@ -40,11 +64,19 @@ func (v VFSOption) String() string {
 		depth += "(but recurse to all directory)"
 	}
 	s := fmt.Sprintf("[Depth: %v]", depth)
+	s += fmt.Sprintf("[Follow: %q]", v.Follow)
 	s += fmt.Sprintf("[Grouping: %q]", v.Grouping)
 	s += fmt.Sprintf("[Sort: %q]", v.ByField)
+	s += fmt.Sprintf("[SortKeys: %q]", v.SortKeys)
 	s += fmt.Sprintf("[Skips: %q]", v.Skips)
 	s += fmt.Sprintf("[ViewFields: %q]", v.ViewFields)
 	s += fmt.Sprintf("[ViewType: %q]", v.ViewType)
+	s += fmt.Sprintf("[IsXattrValue: %v]", v.IsXattrValue)
+	s += fmt.Sprintf("[GridField: %q]", v.GridField)
+	s += fmt.Sprintf("[IsGridAcross: %v]", v.IsGridAcross)
+	s += fmt.Sprintf("[ViewName: %q]", v.ViewName)
+	s += fmt.Sprintf("[IsIcon: %v]", v.IsIcon)
+	s += fmt.Sprintf("[ColorMode: %q]", v.ColorMode)
 	return s
 }
 
@@ -67,7 +99,12 @@ func (s *VFSOption) IsRelPathNotView(relpath string) bool {
 	return curlevel > s.Depth
 }
 
+// Sort sorts dxs by SortKeys if it is not empty, otherwise by ByField
 func (v *VFSOption) Sort(dxs []DirEntryX) {
+	if len(v.SortKeys) > 0 {
+		v.SortKeys.Sort(dxs)
+		return
+	}
 	v.ByField.Sort(dxs)
 }
 
@@ -77,6 +114,10 @@ func (opt *VFSOption) Check() {
 	if opt == nil {
 		opt = NewVFSOption()
 	} else {
+		if !opt.Follow.IsOk() {
+			opt.Follow = FollowCommandLine
+		}
+
 		if !opt.Grouping.IsOk() {
 			opt.Grouping = GroupNone
 		}
@@ -100,6 +141,17 @@ func (opt *VFSOption) Check() {
 		if !opt.ViewFields.IsOk() {
 			opt.ViewFields = DefaultViewField
 		}
+
+		// GridField must be one field other than name (Count() always includes ViewFieldName)
+		if f := opt.GridField; f != 0 && (f&(f-1) != 0 || f&ViewFieldName != 0 || !f.IsOk()) {
+			paw.Logger.Warnf("ignore grid field %q, which must be a single field", opt.GridField)
+			opt.GridField = 0
+		}
+
+		if _, ok := LookupView(opt.ViewName); len(opt.ViewName) > 0 && !ok {
+			paw.Logger.Warnf("ignore unknown view %q, use %q", opt.ViewName, opt.ViewType)
+			opt.ViewName = ""
+		}
 	}
 }
 
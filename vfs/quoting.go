@@ -0,0 +1,208 @@
+package vfs
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/shyang107/paw"
+)
+
+// QuotingStyle is the style of quoting names in views (like as `ls --quoting-style=WORD`), so that the unusual names (e.g. with newlines, control characters, trailing spaces or invalid UTF-8) do not break the layout.
+type QuotingStyle int
+
+const (
+	// QuotingLiteral shows names as they are
+	QuotingLiteral QuotingStyle = iota
+	// QuotingShell quotes names by single quotes if they need quoting for shell, and shows the non-printable characters as '?'
+	QuotingShell
+	// QuotingShellEscape quotes names like QuotingShell, but escapes the non-printable characters like as $'\n'
+	QuotingShellEscape
+	// QuotingC quotes names by double quotes and escapes them like as C strings
+	QuotingC
+	// QuotingEscape escapes names like QuotingC and spaces by '\', but without quotes
+	QuotingEscape
+)
+
+// QuotingStyleNames are the names of QuotingStyle
+var QuotingStyleNames = map[QuotingStyle]string{
+	QuotingLiteral:     "literal",
+	QuotingShell:       "shell",
+	QuotingShellEscape: "shell-escape",
+	QuotingC:           "c",
+	QuotingEscape:      "escape",
+}
+
+func (q QuotingStyle) String() string {
+	if name, ok := QuotingStyleNames[q]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseQuotingStyle returns the QuotingStyle of `name`: literal, shell, shell-escape, c or escape
+func ParseQuotingStyle(name string) (QuotingStyle, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for q, n := range QuotingStyleNames {
+		if n == name {
+			return q, nil
+		}
+	}
+	return QuotingLiteral, fmt.Errorf("invalid quoting style %q, use literal, shell, shell-escape, c or escape", name)
+}
+
+// Quote returns `name` quoted in the style q
+func (q QuotingStyle) Quote(name string) string {
+	switch q {
+	case QuotingShell:
+		return shellQuote(name, false)
+	case QuotingShellEscape:
+		return shellQuote(name, true)
+	case QuotingC:
+		return `"` + cEscape(name, false) + `"`
+	case QuotingEscape:
+		return cEscape(name, true)
+	}
+	return name
+}
+
+// quote returns s, the name or path of de, quoted in the QuotingStyle of de (see quotingOf)
+func quote(de DirEntryX, s string) string {
+	return quotingOf(de).Quote(s)
+}
+
+// quotingOf returns the QuotingStyle of the VFSOption of de, or QuotingLiteral if de has no option
+func quotingOf(de DirEntryX) QuotingStyle {
+	if opt := optionOf(de); opt != nil {
+		return opt.Quoting
+	}
+	return QuotingLiteral
+}
+
+// shellMetaChars are the characters needing quotes for shell
+const shellMetaChars = " \t\n!\"#$&'()*;<=>?[\\]^`{|}~"
+
+// isPrintable returns true if rune r, decoded in `size` bytes, is valid and printable
+func isPrintable(r rune, size int) bool {
+	return !(r == utf8.RuneError && size <= 1) && unicode.IsPrint(r)
+}
+
+// shellQuote quotes `s` by single quotes if it needs quoting for shell; the non-printable characters are shown as '?', or escaped like as $'\n' if isEscape.
+func shellQuote(s string, isEscape bool) string {
+	if len(s) == 0 {
+		return "''"
+	}
+	var (
+		isQuote = strings.ContainsAny(s, shellMetaChars)
+		parts   = make([]string, 0, 1)
+		sb      = new(strings.Builder)
+	)
+	flush := func() {
+		if sb.Len() > 0 {
+			parts = append(parts, sb.String())
+			sb.Reset()
+		}
+	}
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if isPrintable(r, size) {
+			sb.WriteString(s[i : i+size])
+			i += size
+			continue
+		}
+		isQuote = true
+		if !isEscape {
+			sb.WriteByte('?')
+			i += size
+			continue
+		}
+		flush()
+		j := i
+		for j < len(s) {
+			r, size := utf8.DecodeRuneInString(s[j:])
+			if isPrintable(r, size) {
+				break
+			}
+			j += size
+		}
+		parts = append(parts, "$'"+cEscape(s[i:j], false)+"'")
+		i = j
+	}
+	flush()
+	if !isQuote {
+		return s
+	}
+	for i, p := range parts {
+		if !strings.HasPrefix(p, "$'") {
+			parts[i] = "'" + strings.ReplaceAll(p, "'", `'\''`) + "'"
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// cEscapes are the escapes of C strings
+var cEscapes = map[rune]string{
+	'\a': `\a`,
+	'\b': `\b`,
+	'\f': `\f`,
+	'\n': `\n`,
+	'\r': `\r`,
+	'\t': `\t`,
+	'\v': `\v`,
+	'\\': `\\`,
+}
+
+// cEscape escapes `s` like as C strings: the non-printable characters and the bytes of invalid UTF-8 are escaped in octal (e.g. \033); the double quotes are escaped unless isEscapeSpace, which escapes spaces instead.
+func cEscape(s string, isEscapeSpace bool) string {
+	sb := new(strings.Builder)
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case cEscapes[r] != "":
+			sb.WriteString(cEscapes[r])
+		case r == '"' && !isEscapeSpace:
+			sb.WriteString(`\"`)
+		case r == ' ' && isEscapeSpace:
+			sb.WriteString(`\ `)
+		case !isPrintable(r, size):
+			for _, b := range []byte(s[i : i+size]) {
+				fmt.Fprintf(sb, `\%03o`, b)
+			}
+		default:
+			sb.WriteString(s[i : i+size])
+		}
+		i += size
+	}
+	return sb.String()
+}
+
+// FprintPaths writes the paths of the entries in v (in the order of views, under VFSOption.Depth, ViewNoDirs and ViewNoFiles) to w, each of them followed by `sep`; the paths are not quoted, e.g. sep is '\x00' for `xargs -0`.
+func (v *VFS) FprintPaths(w io.Writer, sep byte) {
+	var (
+		rootdir                        = v.RootDir()
+		_, isViewNoDirs, isViewNoFiles = v.hasX_NoDir_NoFiles()
+	)
+	for _, rp := range rootdir.relpaths {
+		if rootdir.opt.IsRelPathNotView(rp) {
+			continue
+		}
+		cur, err := rootdir.getDir(rp)
+		if err != nil {
+			paw.Logger.Error(err)
+			continue
+		}
+		des, _ := cur.ReadDirAll()
+		for _, de := range des {
+			if de.IsDir() && isViewNoDirs || !de.IsDir() && isViewNoFiles {
+				continue
+			}
+			io.WriteString(w, de.Path())
+			w.Write([]byte{sep})
+		}
+		if rootdir.opt.Depth == 0 {
+			break
+		}
+	}
+}
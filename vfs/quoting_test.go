@@ -0,0 +1,87 @@
+package vfs
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shyang107/paw"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuotingStyleQuote(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		style QuotingStyle
+		name  string
+		want  string
+	}{
+		{QuotingLiteral, "a b\n", "a b\n"},
+		{QuotingShell, "abc", "abc"},
+		{QuotingShell, "", "''"},
+		{QuotingShell, "a b", "'a b'"},
+		{QuotingShell, "it's", `'it'\''s'`},
+		{QuotingShell, "a\nb", "'a?b'"},
+		{QuotingShell, "中文", "中文"},
+		{QuotingShellEscape, "abc", "abc"},
+		{QuotingShellEscape, "a b", "'a b'"},
+		{QuotingShellEscape, "a\nb", `'a'$'\n''b'`},
+		{QuotingShellEscape, "\x1b[m", `$'\033''[m'`},
+		{QuotingShellEscape, "a\xffb", `'a'$'\377''b'`},
+		{QuotingC, "abc", `"abc"`},
+		{QuotingC, `a"b\c`, `"a\"b\\c"`},
+		{QuotingC, "a b\tc", `"a b\tc"`},
+		{QuotingC, "\x1b", `"\033"`},
+		{QuotingEscape, "a b", `a\ b`},
+		{QuotingEscape, `a"b`, `a"b`},
+		{QuotingEscape, "a\nb", `a\nb`},
+	}
+	for _, tt := range tests {
+		assert.Equal(tt.want, tt.style.Quote(tt.name), "%v of %q", tt.style, tt.name)
+	}
+}
+
+func TestParseQuotingStyle(t *testing.T) {
+	assert := assert.New(t)
+
+	for q, name := range QuotingStyleNames {
+		got, err := ParseQuotingStyle(" " + name + " ")
+		assert.NoError(err, name)
+		assert.Equal(q, got, name)
+	}
+	_, err := ParseQuotingStyle("locale")
+	assert.Error(err)
+}
+
+func TestViewQuoting(t *testing.T) {
+	assert := assert.New(t)
+
+	root := t.TempDir()
+	assert.NoError(os.WriteFile(filepath.Join(root, "a b.txt"), nil, 0644))
+
+	// the quoting style belongs to the option of every VFS, views of other VFS are not affected
+	tests := []struct {
+		vt      ViewType
+		quoting QuotingStyle
+		want    string
+	}{
+		{ViewList, QuotingShell, "'a b.txt'"},
+		{ViewGrid, QuotingC, `"a b.txt"`},
+		{ViewClassify, QuotingEscape, `a\ b.txt`},
+		{ViewList, QuotingLiteral, " a b.txt"},
+	}
+	for _, tt := range tests {
+		opt := NewVFSOption()
+		opt.ViewType = tt.vt
+		opt.Quoting = tt.quoting
+		v, err := NewVFS(root, opt)
+		assert.NoError(err)
+		assert.NoError(v.BuildFS())
+
+		buf := new(bytes.Buffer)
+		v.View(buf)
+		assert.Contains(paw.StripANSI(buf.String()), tt.want, "view %v, quoting %v", tt.vt, tt.quoting)
+	}
+}
@@ -0,0 +1,315 @@
+package vfs
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os/user"
+	"sort"
+	"strings"
+
+	"github.com/pkg/xattr"
+	"github.com/shyang107/paw/cast"
+)
+
+const (
+	// XattrACLAccess is the name of extended attribute storing POSIX access ACL
+	XattrACLAccess = "system.posix_acl_access"
+	// XattrACLDefault is the name of extended attribute storing POSIX default ACL of directory
+	XattrACLDefault = "system.posix_acl_default"
+	// XattrCapability is the name of extended attribute storing file capabilities
+	XattrCapability = "security.capability"
+	// XattrSELinux is the name of extended attribute storing SELinux security context
+	XattrSELinux = "security.selinux"
+
+	// ACLSymbol is the mark of permissions when file has ACL (like as `ls -l`)
+	ACLSymbol = "+"
+)
+
+// tags and permissions of POSIX ACL entry, see `acl/include/acl_ea.h`
+const (
+	aclEAVersion = 0x0002
+
+	aclUserObj  = 0x01
+	aclUser     = 0x02
+	aclGroupObj = 0x04
+	aclGroup    = 0x08
+	aclMask     = 0x10
+	aclOther    = 0x20
+)
+
+// magic numbers of `struct vfs_cap_data`, see `linux/capability.h`
+const (
+	vfsCapRevisionMask = 0xFF000000
+	vfsCapRevision1    = 0x01000000
+	vfsCapRevision2    = 0x02000000
+	vfsCapRevision3    = 0x03000000
+	vfsCapFlagsEffect  = 0x000001
+)
+
+var (
+	errInvalidACL        = errors.New("invalid POSIX ACL")
+	errInvalidCapability = errors.New("invalid file capability")
+
+	// CapabilityNames are names of Linux capabilities indexed by the number of capability
+	CapabilityNames = []string{
+		"cap_chown",
+		"cap_dac_override",
+		"cap_dac_read_search",
+		"cap_fowner",
+		"cap_fsetid",
+		"cap_kill",
+		"cap_setgid",
+		"cap_setuid",
+		"cap_setpcap",
+		"cap_linux_immutable",
+		"cap_net_bind_service",
+		"cap_net_broadcast",
+		"cap_net_admin",
+		"cap_net_raw",
+		"cap_ipc_lock",
+		"cap_ipc_owner",
+		"cap_sys_module",
+		"cap_sys_rawio",
+		"cap_sys_chroot",
+		"cap_sys_ptrace",
+		"cap_sys_pacct",
+		"cap_sys_admin",
+		"cap_sys_boot",
+		"cap_sys_nice",
+		"cap_sys_resource",
+		"cap_sys_time",
+		"cap_sys_tty_config",
+		"cap_mknod",
+		"cap_lease",
+		"cap_audit_write",
+		"cap_audit_control",
+		"cap_setfcap",
+		"cap_mac_override",
+		"cap_mac_admin",
+		"cap_syslog",
+		"cap_wake_alarm",
+		"cap_block_suspend",
+		"cap_audit_read",
+		"cap_perfmon",
+		"cap_bpf",
+		"cap_checkpoint_restore",
+	}
+)
+
+// securityAttrs stores the decoded security attributes of a file
+type securityAttrs struct {
+	acl        []string // full ACL entries, default ACL entries are prefixed by "default:"
+	hasACL     bool
+	capability string
+	context    string
+}
+
+func getSecurityAttrs(path string) *securityAttrs {
+	s := &securityAttrs{}
+	if acl, err := GetACL(path); err == nil && len(acl) > 0 {
+		s.acl = acl
+		s.hasACL = true
+	}
+	s.capability, _ = GetCapability(path)
+	s.context, _ = GetSELinuxContext(path)
+	return s
+}
+
+// GetACL returns the POSIX ACL entries (access and default) of path in the form of `getfacl`. It returns nil if path has no extended ACL.
+func GetACL(path string) (entries []string, err error) {
+	if b, err := xattr.Get(path, XattrACLAccess); err == nil {
+		acl, err := decodeACL(b)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, acl...)
+	}
+	if b, err := xattr.Get(path, XattrACLDefault); err == nil {
+		acl, err := decodeACL(b)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range acl {
+			entries = append(entries, "default:"+a)
+		}
+	}
+	return entries, nil
+}
+
+func decodeACL(b []byte) ([]string, error) {
+	if len(b) < 4 || (len(b)-4)%8 != 0 ||
+		binary.LittleEndian.Uint32(b[:4]) != aclEAVersion {
+		return nil, errInvalidACL
+	}
+	entries := make([]string, 0, (len(b)-4)/8)
+	for i := 4; i < len(b); i += 8 {
+		tag := binary.LittleEndian.Uint16(b[i : i+2])
+		perm := binary.LittleEndian.Uint16(b[i+2 : i+4])
+		id := binary.LittleEndian.Uint32(b[i+4 : i+8])
+		var qualifier, kind string
+		switch tag {
+		case aclUserObj:
+			kind = "user"
+		case aclUser:
+			kind = "user"
+			qualifier = aclUserName(id)
+		case aclGroupObj:
+			kind = "group"
+		case aclGroup:
+			kind = "group"
+			qualifier = aclGroupName(id)
+		case aclMask:
+			kind = "mask"
+		case aclOther:
+			kind = "other"
+		default:
+			return nil, errInvalidACL
+		}
+		entries = append(entries, kind+":"+qualifier+":"+aclPermS(perm))
+	}
+	return entries, nil
+}
+
+func aclPermS(perm uint16) string {
+	s := []byte("---")
+	if perm&4 != 0 {
+		s[0] = 'r'
+	}
+	if perm&2 != 0 {
+		s[1] = 'w'
+	}
+	if perm&1 != 0 {
+		s[2] = 'x'
+	}
+	return string(s)
+}
+
+func aclUserName(id uint32) string {
+	if u, err := user.LookupId(cast.ToString(id)); err == nil {
+		return u.Username
+	}
+	return cast.ToString(id)
+}
+
+func aclGroupName(id uint32) string {
+	if g, err := user.LookupGroupId(cast.ToString(id)); err == nil {
+		return g.Name
+	}
+	return cast.ToString(id)
+}
+
+// GetCapability returns the file capabilities of path in the form of `getcap`, e.g. "cap_net_bind_service+ep". It returns "" if path has no capability.
+func GetCapability(path string) (string, error) {
+	b, err := xattr.Get(path, XattrCapability)
+	if err != nil {
+		return "", err
+	}
+	return decodeCapability(b)
+}
+
+func decodeCapability(b []byte) (string, error) {
+	if len(b) < 4 {
+		return "", errInvalidCapability
+	}
+	magic := binary.LittleEndian.Uint32(b[:4])
+	nu32 := 0
+	switch magic & vfsCapRevisionMask {
+	case vfsCapRevision1:
+		nu32 = 1
+	case vfsCapRevision2, vfsCapRevision3:
+		nu32 = 2
+	default:
+		return "", errInvalidCapability
+	}
+	if len(b) < 4+nu32*8 {
+		return "", errInvalidCapability
+	}
+	var permitted, inheritable uint64
+	for i := 0; i < nu32; i++ {
+		off := 4 + i*8
+		permitted |= uint64(binary.LittleEndian.Uint32(b[off:off+4])) << (32 * i)
+		inheritable |= uint64(binary.LittleEndian.Uint32(b[off+4:off+8])) << (32 * i)
+	}
+	isEffective := magic&vfsCapFlagsEffect != 0
+
+	// group capabilities by their flags, e.g. "cap_chown,cap_kill+ep cap_setuid+i"
+	groups := make(map[string][]string)
+	for i := 0; i < 64; i++ {
+		bit := uint64(1) << i
+		if permitted&bit == 0 && inheritable&bit == 0 {
+			continue
+		}
+		flags := ""
+		if isEffective {
+			flags += "e"
+		}
+		if inheritable&bit != 0 {
+			flags += "i"
+		}
+		if permitted&bit != 0 {
+			flags += "p"
+		}
+		groups[flags] = append(groups[flags], capabilityName(i))
+	}
+	if len(groups) == 0 {
+		return "", nil
+	}
+	flagss := make([]string, 0, len(groups))
+	for flags := range groups {
+		flagss = append(flagss, flags)
+	}
+	sort.Strings(flagss)
+	caps := make([]string, 0, len(groups))
+	for _, flags := range flagss {
+		caps = append(caps, strings.Join(groups[flags], ",")+"+"+flags)
+	}
+	return strings.Join(caps, " "), nil
+}
+
+func capabilityName(i int) string {
+	if i < len(CapabilityNames) {
+		return CapabilityNames[i]
+	}
+	return fmt.Sprintf("cap_%d", i)
+}
+
+// GetSELinuxContext returns the SELinux security context of path, e.g. "system_u:object_r:bin_t:s0". It returns "" if path has no security context.
+func GetSELinuxContext(path string) (string, error) {
+	b, err := xattr.Get(path, XattrSELinux)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(b), "\x00"), nil
+}
+
+// aclShortS returns the extended entries of ACL (named users, named groups and mask) used in ViewFieldACL
+func aclShortS(acl []string) string {
+	entries := make([]string, 0, len(acl))
+	for _, a := range acl {
+		if strings.HasPrefix(a, "default:") {
+			continue
+		}
+		if strings.HasPrefix(a, "user::") ||
+			strings.HasPrefix(a, "group::") ||
+			strings.HasPrefix(a, "other::") {
+			continue
+		}
+		entries = append(entries, a)
+	}
+	if len(entries) == 0 {
+		if len(acl) > 0 {
+			return "default"
+		}
+		return "-"
+	}
+	return strings.Join(entries, ",")
+}
+
+// securityS returns s or "-" if s is empty, used in ViewFieldCapability and ViewFieldContext
+func securityS(s string) string {
+	if len(s) == 0 {
+		return "-"
+	}
+	return s
+}
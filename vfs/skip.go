@@ -294,6 +294,34 @@ var SkipDirer = NewSkipper("«SkipDirer»", func(de DirEntry) bool {
 	return false
 })
 
+// NewSkipperNoXattrs returns a new Skipper used to skip files without any extended attribute matching one of patterns (see filepath.Match, e.g. "user.*"). Directories are never skipped.
+// 	Because fs.DirEntry has no extended attributes, it works on DirEntryX only, which is checked again after creating DirEntryX during building VFS.
+// 	see examples/vfs
+func NewSkipperNoXattrs(patterns ...string) Skiper {
+	name := "«SkipperNoXattrs: " + strings.Join(patterns, ",") + "»"
+	return NewSkipper(name, func(de DirEntry) bool {
+		dx, ok := de.(DirEntryX)
+		if !ok || de.IsDir() {
+			return false
+		}
+		return !hasXattrOf(dx, patterns)
+	})
+}
+
+// NewSkipperNoMimeTypes returns a new Skipper used to skip files whose MIME type (detected by content) does not match any of patterns (see filepath.Match, e.g. "image/*"). Directories are never skipped.
+// 	Like as NewSkipperNoXattrs, it works on DirEntryX only.
+// 	see examples/vfs
+func NewSkipperNoMimeTypes(patterns ...string) Skiper {
+	name := "«SkipperNoMimeTypes: " + strings.Join(patterns, ",") + "»"
+	return NewSkipper(name, func(de DirEntry) bool {
+		dx, ok := de.(DirEntryX)
+		if !ok || de.IsDir() {
+			return false
+		}
+		return !hasMimeOf(dx, patterns)
+	})
+}
+
 // SkipperRe is a func to skip DirEntry using regex
 // 	see examples/vfs
 type SkipperRe struct {
@@ -1,8 +1,11 @@
 package vfs
 
 import (
+	"fmt"
+	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/shyang107/paw"
 )
@@ -142,8 +145,147 @@ var (
 	})
 
 	// ByLowerNameLessFuncR = ByLowerNameFunc.SetReverse()
+
+	ByMimeTypeLessFunc = ByLessFunc(func(fi, fj DirEntryX) bool {
+		return fi.FileType().Mime < fj.FileType().Mime
+	})
+
+	ByLinesLessFunc = ByLessFunc(func(fi, fj DirEntryX) bool {
+		return textLines(fi) < textLines(fj)
+	})
+
+	ByUserLessFunc = ByLessFunc(func(fi, fj DirEntryX) bool {
+		return strings.ToLower(fi.User()) < strings.ToLower(fj.User())
+	})
+
+	ByGroupLessFunc = ByLessFunc(func(fi, fj DirEntryX) bool {
+		return strings.ToLower(fi.Group()) < strings.ToLower(fj.Group())
+	})
+
+	ByMd5LessFunc = ByLessFunc(func(fi, fj DirEntryX) bool {
+		return fi.Md5() < fj.Md5()
+	})
+
+	// ByExtensionLessFunc sorts by the extension of name (case insensitive), directories first
+	ByExtensionLessFunc = ByLessFunc(func(fi, fj DirEntryX) bool {
+		if fi.IsDir() != fj.IsDir() {
+			return fi.IsDir()
+		}
+		return strings.ToLower(filepath.Ext(fi.Name())) < strings.ToLower(filepath.Ext(fj.Name()))
+	})
+
+	// ByGitLessFunc sorts by git status: unmerged, changed (staged or not), untracked, unmodified and then ignored
+	ByGitLessFunc = ByLessFunc(func(fi, fj DirEntryX) bool {
+		return gitSortRank(fi.XY()) < gitSortRank(fj.XY())
+	})
+
+	// ByVersionLessFunc sorts by name in natural (version) order, e.g. "file2" < "file10" and "v1.9" < "v1.10"
+	ByVersionLessFunc = ByLessFunc(func(fi, fj DirEntryX) bool {
+		return paw.NaturalLess(fi.Name(), fj.Name())
+	})
+
+	// ByCollateLessFunc sorts by name in the collation order of locale (see SetCollation), e.g. "é" is next to "e" rather than after "z"
+	ByCollateLessFunc = ByLessFunc(func(fi, fj DirEntryX) bool {
+		return collator("").Less(fi.Name(), fj.Name())
+	})
+
+	// ByPinyinLessFunc sorts by name with Han characters in pinyin order
+	ByPinyinLessFunc = ByLessFunc(func(fi, fj DirEntryX) bool {
+		return collator("pinyin").Less(fi.Name(), fj.Name())
+	})
+
+	// ByZhuyinLessFunc sorts by name with Han characters in zhuyin (Bopomofo) order
+	ByZhuyinLessFunc = ByLessFunc(func(fi, fj DirEntryX) bool {
+		return collator("zhuyin").Less(fi.Name(), fj.Name())
+	})
+
+	// ByStrokeLessFunc sorts by name with Han characters in the order of the number of strokes
+	ByStrokeLessFunc = ByLessFunc(func(fi, fj DirEntryX) bool {
+		return collator("stroke").Less(fi.Name(), fj.Name())
+	})
+)
+
+// gitSortRank returns the rank of git status `xy` in sorting
+func gitSortRank(xy string) int {
+	rank := 3
+	for _, c := range xy {
+		r := 3
+		switch GitStatusCode(c) {
+		case GitUpdatedButUnmerged:
+			r = 0
+		case GitModified, GitAdded, GitDeleted, GitRenamed, GitCopied, GitChanged:
+			r = 1
+		case GitUntracked:
+			r = 2
+		case GitIgnored, gitIgnored:
+			r = 4
+		}
+		if r < rank || (r == 4 && rank == 3) {
+			rank = r
+		}
+	}
+	return rank
+}
+
+var (
+	// collateLocale is the locale of collation of SortByCollate, SortByPinyin, SortByZhuyin and SortByStroke (see SetCollation)
+	collateLocale string
+	// collators caches the collators of collateLocale by the ordering of Han characters ("" is the default of locale)
+	collators   = map[string]*paw.Collator{}
+	collatorsMu sync.Mutex
 )
 
+// SetCollation sets the locale of collation of sorting by name in SortByCollate, SortByPinyin, SortByZhuyin and SortByStroke (see paw.NewCollator); the empty locale is the locale of environment (LC_ALL, LC_COLLATE or LANG).
+func SetCollation(locale string) error {
+	c, err := paw.NewCollator(locale, "")
+	if err != nil {
+		return err
+	}
+	collatorsMu.Lock()
+	defer collatorsMu.Unlock()
+	collateLocale = locale
+	collators = map[string]*paw.Collator{"": c}
+	return nil
+}
+
+// collator returns the collator of collateLocale with the ordering of Han characters `hanOrder`; it falls back to the root collation if the locale is invalid.
+func collator(hanOrder string) *paw.Collator {
+	collatorsMu.Lock()
+	defer collatorsMu.Unlock()
+	if c, ok := collators[hanOrder]; ok {
+		return c
+	}
+	c, err := paw.NewCollator(collateLocale, hanOrder)
+	if err != nil {
+		paw.Logger.Warn(err)
+		c, _ = paw.NewCollator("C", hanOrder)
+	}
+	collators[hanOrder] = c
+	return c
+}
+
+// Reverse returns the less func in the reverse order of f
+func (f ByLessFunc) Reverse() ByLessFunc {
+	return func(fi, fj DirEntryX) bool {
+		return f(fj, fi)
+	}
+}
+
+// ChainLessFuncs composes less funcs `fs` into one: fi and fj are compared by the first func which does not consider them equal, i.e. the later funcs break ties of the former.
+func ChainLessFuncs(fs ...ByLessFunc) ByLessFunc {
+	return func(fi, fj DirEntryX) bool {
+		for _, f := range fs {
+			switch {
+			case f(fi, fj):
+				return true
+			case f(fj, fi):
+				return false
+			}
+		}
+		return false
+	}
+}
+
 type SortKey int
 
 const (
@@ -156,6 +298,18 @@ const (
 	SortByCTime
 	SortByName
 	SortByLowerName
+	SortByMimeType
+	SortByLines
+	SortByUser
+	SortByGroup
+	SortByMd5
+	SortByExtension
+	SortByGit
+	SortByVersion
+	SortByCollate
+	SortByPinyin
+	SortByZhuyin
+	SortByStroke
 
 	SortByNone
 	SortReverse
@@ -169,6 +323,18 @@ const (
 	SortByCTimeR     = SortReverse | SortByCTime
 	SortByNameR      = SortReverse | SortByName
 	SortByLowerNameR = SortReverse | SortByLowerName
+	SortByMimeTypeR  = SortReverse | SortByMimeType
+	SortByLinesR     = SortReverse | SortByLines
+	SortByUserR      = SortReverse | SortByUser
+	SortByGroupR     = SortReverse | SortByGroup
+	SortByMd5R       = SortReverse | SortByMd5
+	SortByExtensionR = SortReverse | SortByExtension
+	SortByGitR       = SortReverse | SortByGit
+	SortByVersionR   = SortReverse | SortByVersion
+	SortByCollateR   = SortReverse | SortByCollate
+	SortByPinyinR    = SortReverse | SortByPinyin
+	SortByZhuyinR    = SortReverse | SortByZhuyin
+	SortByStrokeR    = SortReverse | SortByStroke
 )
 
 var (
@@ -182,6 +348,18 @@ var (
 		SortByCTime:      ByCTimeLessFunc,
 		SortByName:       ByNameLessFunc,
 		SortByLowerName:  ByLowerNameLessFunc,
+		SortByMimeType:   ByMimeTypeLessFunc,
+		SortByLines:      ByLinesLessFunc,
+		SortByUser:       ByUserLessFunc,
+		SortByGroup:      ByGroupLessFunc,
+		SortByMd5:        ByMd5LessFunc,
+		SortByExtension:  ByExtensionLessFunc,
+		SortByGit:        ByGitLessFunc,
+		SortByVersion:    ByVersionLessFunc,
+		SortByCollate:    ByCollateLessFunc,
+		SortByPinyin:     ByPinyinLessFunc,
+		SortByZhuyin:     ByZhuyinLessFunc,
+		SortByStroke:     ByStrokeLessFunc,
 		SortByINodeR:     ByINodeLessFunc,
 		SortByHDLinksR:   ByHDLinksLessFunc,
 		SortBySizeR:      BySizeLessFunc,
@@ -191,6 +369,18 @@ var (
 		SortByCTimeR:     ByCTimeLessFunc,
 		SortByNameR:      ByNameLessFunc,
 		SortByLowerNameR: ByLowerNameLessFunc,
+		SortByMimeTypeR:  ByMimeTypeLessFunc,
+		SortByLinesR:     ByLinesLessFunc,
+		SortByUserR:      ByUserLessFunc,
+		SortByGroupR:     ByGroupLessFunc,
+		SortByMd5R:       ByMd5LessFunc,
+		SortByExtensionR: ByExtensionLessFunc,
+		SortByGitR:       ByGitLessFunc,
+		SortByVersionR:   ByVersionLessFunc,
+		SortByCollateR:   ByCollateLessFunc,
+		SortByPinyinR:    ByPinyinLessFunc,
+		SortByZhuyinR:    ByZhuyinLessFunc,
+		SortByStrokeR:    ByStrokeLessFunc,
 	}
 
 	SortFuncFields = map[SortKey]string{
@@ -204,6 +394,18 @@ var (
 		SortByCTime:      "CTime",
 		SortByName:       "Name",
 		SortByLowerName:  "LowerName",
+		SortByMimeType:   "MimeType",
+		SortByLines:      "Lines",
+		SortByUser:       "User",
+		SortByGroup:      "Group",
+		SortByMd5:        "Md5",
+		SortByExtension:  "Extension",
+		SortByGit:        "Git",
+		SortByVersion:    "Version",
+		SortByCollate:    "Collate",
+		SortByPinyin:     "Pinyin",
+		SortByZhuyin:     "Zhuyin",
+		SortByStroke:     "Stroke",
 		SortByINodeR:     "INodeR",
 		SortByHDLinksR:   "HDLinksR",
 		SortBySizeR:      "SizeR",
@@ -213,6 +415,18 @@ var (
 		SortByCTimeR:     "CTimeR",
 		SortByNameR:      "NameR",
 		SortByLowerNameR: "LowerNameR",
+		SortByMimeTypeR:  "MimeTypeR",
+		SortByLinesR:     "LinesR",
+		SortByUserR:      "UserR",
+		SortByGroupR:     "GroupR",
+		SortByMd5R:       "Md5R",
+		SortByExtensionR: "ExtensionR",
+		SortByGitR:       "GitR",
+		SortByVersionR:   "VersionR",
+		SortByCollateR:   "CollateR",
+		SortByPinyinR:    "PinyinR",
+		SortByZhuyinR:    "ZhuyinR",
+		SortByStrokeR:    "StrokeR",
 	}
 	SortKeyNames = map[SortKey]string{
 		SortByNone:       "SortByNone",
@@ -225,6 +439,18 @@ var (
 		SortByCTime:      "SortByCTime",
 		SortByName:       "SortByName",
 		SortByLowerName:  "SortByLowerName",
+		SortByMimeType:   "SortByMimeType",
+		SortByLines:      "SortByLines",
+		SortByUser:       "SortByUser",
+		SortByGroup:      "SortByGroup",
+		SortByMd5:        "SortByMd5",
+		SortByExtension:  "SortByExtension",
+		SortByGit:        "SortByGit",
+		SortByVersion:    "SortByVersion",
+		SortByCollate:    "SortByCollate",
+		SortByPinyin:     "SortByPinyin",
+		SortByZhuyin:     "SortByZhuyin",
+		SortByStroke:     "SortByStroke",
 		SortByINodeR:     "SortByINodeR",
 		SortByHDLinksR:   "SortByHDLinksR",
 		SortBySizeR:      "SortBySizeR",
@@ -234,6 +460,18 @@ var (
 		SortByCTimeR:     "SortByCTimeR",
 		SortByNameR:      "SortByNameR",
 		SortByLowerNameR: "SortByLowerNameR",
+		SortByMimeTypeR:  "SortByMimeTypeR",
+		SortByLinesR:     "SortByLinesR",
+		SortByUserR:      "SortByUserR",
+		SortByGroupR:     "SortByGroupR",
+		SortByMd5R:       "SortByMd5R",
+		SortByExtensionR: "SortByExtensionR",
+		SortByGitR:       "SortByGitR",
+		SortByVersionR:   "SortByVersionR",
+		SortByCollateR:   "SortByCollateR",
+		SortByPinyinR:    "SortByPinyinR",
+		SortByZhuyinR:    "SortByZhuyinR",
+		SortByStrokeR:    "SortByStrokeR",
 	}
 	SortNameKeys = map[string]SortKey{
 		"SortByNone":       SortByNone,
@@ -246,6 +484,18 @@ var (
 		"SortByCTime":      SortByCTime,
 		"SortByName":       SortByName,
 		"SortByLowerName":  SortByLowerName,
+		"SortByMimeType":   SortByMimeType,
+		"SortByLines":      SortByLines,
+		"SortByUser":       SortByUser,
+		"SortByGroup":      SortByGroup,
+		"SortByMd5":        SortByMd5,
+		"SortByExtension":  SortByExtension,
+		"SortByGit":        SortByGit,
+		"SortByVersion":    SortByVersion,
+		"SortByCollate":    SortByCollate,
+		"SortByPinyin":     SortByPinyin,
+		"SortByZhuyin":     SortByZhuyin,
+		"SortByStroke":     SortByStroke,
 		"SortByINodeR":     SortByINodeR,
 		"SortByHDLinksR":   SortByHDLinksR,
 		"SortBySizeR":      SortBySizeR,
@@ -255,28 +505,76 @@ var (
 		"SortByCTimeR":     SortByCTimeR,
 		"SortByNameR":      SortByNameR,
 		"SortByLowerNameR": SortByLowerNameR,
+		"SortByMimeTypeR":  SortByMimeTypeR,
+		"SortByLinesR":     SortByLinesR,
+		"SortByUserR":      SortByUserR,
+		"SortByGroupR":     SortByGroupR,
+		"SortByMd5R":       SortByMd5R,
+		"SortByExtensionR": SortByExtensionR,
+		"SortByGitR":       SortByGitR,
+		"SortByVersionR":   SortByVersionR,
+		"SortByCollateR":   SortByCollateR,
+		"SortByPinyinR":    SortByPinyinR,
+		"SortByZhuyinR":    SortByZhuyinR,
+		"SortByStrokeR":    SortByStrokeR,
 	}
 
 	SortShortNameKeys = map[string]SortKey{
-		"none":    SortByNone,
-		"inode":   SortByINode,
-		"links":   SortByHDLinks,
-		"size":    SortBySize,
-		"blocks":  SortByBlocks,
-		"mtime":   SortByMTime,
-		"atime":   SortByATime,
-		"ctime":   SortByCTime,
-		"name":    SortByName,
-		"lname":   SortByLowerName,
-		"inoder":  SortByINodeR,
-		"linksr":  SortByHDLinksR,
-		"sizer":   SortBySizeR,
-		"blocksr": SortByBlocksR,
-		"mtimer":  SortByMTimeR,
-		"atimer":  SortByATimeR,
-		"ctimer":  SortByCTimeR,
-		"namer":   SortByNameR,
-		"lnamer":  SortByLowerNameR,
+		"none":     SortByNone,
+		"inode":    SortByINode,
+		"links":    SortByHDLinks,
+		"size":     SortBySize,
+		"blocks":   SortByBlocks,
+		"mtime":    SortByMTime,
+		"atime":    SortByATime,
+		"ctime":    SortByCTime,
+		"name":     SortByName,
+		"lname":    SortByLowerName,
+		"mime":     SortByMimeType,
+		"lines":    SortByLines,
+		"user":     SortByUser,
+		"group":    SortByGroup,
+		"md5":      SortByMd5,
+		"ext":      SortByExtension,
+		"git":      SortByGit,
+		"version":  SortByVersion,
+		"collate":  SortByCollate,
+		"pinyin":   SortByPinyin,
+		"zhuyin":   SortByZhuyin,
+		"stroke":   SortByStroke,
+		"inoder":   SortByINodeR,
+		"linksr":   SortByHDLinksR,
+		"sizer":    SortBySizeR,
+		"blocksr":  SortByBlocksR,
+		"mtimer":   SortByMTimeR,
+		"atimer":   SortByATimeR,
+		"ctimer":   SortByCTimeR,
+		"namer":    SortByNameR,
+		"lnamer":   SortByLowerNameR,
+		"mimer":    SortByMimeTypeR,
+		"linesr":   SortByLinesR,
+		"userr":    SortByUserR,
+		"groupr":   SortByGroupR,
+		"md5r":     SortByMd5R,
+		"extr":     SortByExtensionR,
+		"gitr":     SortByGitR,
+		"versionr": SortByVersionR,
+		"collater": SortByCollateR,
+		"pinyinr":  SortByPinyinR,
+		"zhuyinr":  SortByZhuyinR,
+		"stroker":  SortByStrokeR,
+	}
+	// SortKeyAliases are the synonyms of keys of SortShortNameKeys used by ParseSortKeys
+	SortKeyAliases = map[string]string{
+		"modified":  "mtime",
+		"accessed":  "atime",
+		"created":   "ctime",
+		"extension": "ext",
+		"natural":   "version",
+		"hdlinks":   "links",
+		"locale":    "collate",
+		"bopomofo":  "zhuyin",
+		"strokes":   "stroke",
 	}
 	SortKey2ViewField = map[SortKey]ViewField{
 		SortByINode:      ViewFieldINode,
@@ -288,6 +586,18 @@ var (
 		SortByCTime:      ViewFieldCreated,
 		SortByName:       ViewFieldName,
 		SortByLowerName:  ViewFieldName,
+		SortByMimeType:   ViewFieldMimeType,
+		SortByLines:      ViewFieldLines,
+		SortByUser:       ViewFieldUser,
+		SortByGroup:      ViewFieldGroup,
+		SortByMd5:        ViewFieldMd5,
+		SortByExtension:  ViewFieldName,
+		SortByGit:        ViewFieldGit,
+		SortByVersion:    ViewFieldName,
+		SortByCollate:    ViewFieldName,
+		SortByPinyin:     ViewFieldName,
+		SortByZhuyin:     ViewFieldName,
+		SortByStroke:     ViewFieldName,
 		SortByINodeR:     ViewFieldINode,
 		SortByHDLinksR:   ViewFieldLinks,
 		SortBySizeR:      ViewFieldSize,
@@ -297,6 +607,18 @@ var (
 		SortByCTimeR:     ViewFieldCreated,
 		SortByNameR:      ViewFieldName,
 		SortByLowerNameR: ViewFieldName,
+		SortByMimeTypeR:  ViewFieldMimeType,
+		SortByLinesR:     ViewFieldLines,
+		SortByUserR:      ViewFieldUser,
+		SortByGroupR:     ViewFieldGroup,
+		SortByMd5R:       ViewFieldMd5,
+		SortByExtensionR: ViewFieldName,
+		SortByGitR:       ViewFieldGit,
+		SortByVersionR:   ViewFieldName,
+		SortByCollateR:   ViewFieldName,
+		SortByPinyinR:    ViewFieldName,
+		SortByZhuyinR:    ViewFieldName,
+		SortByStrokeR:    ViewFieldName,
 	}
 )
 
@@ -315,15 +637,37 @@ func (s SortKey) Name() string {
 	}
 }
 
-// Sort is a method on the function type, By, that sorts the argument slice according to the function.
+// IsReverse returns true if s sorts in decreasing order
+func (s SortKey) IsReverse() bool {
+	return s&SortReverse != 0
+}
+
+// LessFunc returns the less func of s, in the reverse order if s.IsReverse(); it returns nil for SortByNone or an invalid key.
+func (s SortKey) LessFunc() ByLessFunc {
+	less, ok := SortLessFuncMap[s]
+	if !ok {
+		return nil
+	}
+	if s.IsReverse() {
+		return less.Reverse()
+	}
+	return less
+}
+
+// Sort sorts dxs stably by s, the ties are sorted by lower name in the same order; SortByNone keeps the order.
 func (s SortKey) Sort(dxs []DirEntryX) {
-	dxa := DirEntryXA(dxs).SetLessFunc(s)
-	key := s.String()
-	if strings.HasSuffix(key, "R") {
-		sort.Sort(sort.Reverse(dxa))
-	} else {
-		sort.Sort(dxa)
+	less := s.LessFunc()
+	if less == nil {
+		return
 	}
+	tie := ByLowerNameLessFunc
+	if s.IsReverse() {
+		tie = tie.Reverse()
+	}
+	less = ChainLessFuncs(less, tie)
+	sort.SliceStable(dxs, func(i, j int) bool {
+		return less(dxs[i], dxs[j])
+	})
 	// switch s {
 	// case SortByINodeR, SortByHDLinksR, SortBySizeR, SortByBlocksR, SortByMTimeR, SortByATimeR, SortByCTimeR, SortByNameR, SortByLowerNameR:
 	// 	sort.Sort(sort.Reverse(dxa))
@@ -337,6 +681,9 @@ func (s SortKey) Sort(dxs []DirEntryX) {
 // IsOk returns true for effective and otherwise not. In genernal, use it in checking.
 func (s SortKey) IsOk() bool {
 	paw.Logger.Debug("checking SortKey..." + paw.Caller(1))
+	if s == SortByNone {
+		return true
+	}
 	if _, ok := SortLessFuncMap[s]; !ok {
 		return false
 	} else {
@@ -487,3 +834,86 @@ func (s DirEntryXA) SetLessFunc(byField SortKey) *DirEntryXA {
 	_DirEntryXALessFunc = SortLessFuncMap[byField]
 	return &s
 }
+
+// SortKeys are the keys of multi-key sorting: the entries are sorted by the first key, and the ties are sorted by the next keys, and then by lower name.
+type SortKeys []SortKey
+
+func (s SortKeys) String() string {
+	names := make([]string, 0, len(s))
+	for _, k := range s {
+		names = append(names, k.Name())
+	}
+	return strings.Join(names, ",")
+}
+
+// LessFunc returns the less func composed of the keys of s and then lower name (see ChainLessFuncs); SortByNone and invalid keys are ignored.
+func (s SortKeys) LessFunc() ByLessFunc {
+	fs := make([]ByLessFunc, 0, len(s)+1)
+	for _, k := range s {
+		if less := k.LessFunc(); less != nil {
+			fs = append(fs, less)
+		}
+	}
+	fs = append(fs, ByLowerNameLessFunc)
+	return ChainLessFuncs(fs...)
+}
+
+// Sort sorts dxs stably by s, ties are broken by lower name in increasing order (even a single key, unlike SortKey.Sort); it does not sort by a single key of SortByNone.
+func (s SortKeys) Sort(dxs []DirEntryX) {
+	if len(s) == 1 && s[0].LessFunc() == nil {
+		return
+	}
+	less := s.LessFunc()
+	sort.SliceStable(dxs, func(i, j int) bool {
+		return less(dxs[i], dxs[j])
+	})
+}
+
+// ViewFields returns the fields of keys of s (see SortKey2ViewField)
+func (s SortKeys) ViewFields() ViewField {
+	var fields ViewField
+	for _, k := range s {
+		fields |= SortKey2ViewField[k]
+	}
+	return fields
+}
+
+// ParseSortKeys parses the keys of multi-key sorting separated by commas, e.g. "ext,size:desc,name"; each key is a short name of SortShortNameKeys (case insensitive) or its synonym, followed by ":asc" or ":desc" (or the suffix "r") for the order.
+func ParseSortKeys(spec string) (SortKeys, error) {
+	keys := SortKeys{}
+	for _, f := range strings.Split(spec, ",") {
+		f = strings.ToLower(strings.TrimSpace(f))
+		if len(f) == 0 {
+			continue
+		}
+		var isDesc bool
+		if i := strings.LastIndexByte(f, ':'); i >= 0 {
+			switch f[i+1:] {
+			case "asc":
+			case "desc":
+				isDesc = true
+			default:
+				return nil, fmt.Errorf("sort key %q: unknown order %q, use asc or desc", f, f[i+1:])
+			}
+			f = f[:i]
+		}
+		if name, ok := SortKeyAliases[f]; ok {
+			f = name
+		} else if name, ok := SortKeyAliases[strings.TrimSuffix(f, "r")]; ok && strings.HasSuffix(f, "r") {
+			// synonym with the suffix "r", e.g. "naturalr"
+			f = name + "r"
+		}
+		key, ok := SortShortNameKeys[f]
+		if !ok {
+			return nil, fmt.Errorf("unknown sort key %q", f)
+		}
+		if isDesc {
+			key ^= SortReverse
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no sort key in %q", spec)
+	}
+	return keys, nil
+}
@@ -0,0 +1,103 @@
+package vfs
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestFiles creates the files of sizes in a temporary directory and returns them
+func newTestFiles(t *testing.T, sizes map[string]int) []DirEntryX {
+	t.Helper()
+	root := t.TempDir()
+	git := NewGitStatus(root)
+	dxs := make([]DirEntryX, 0, len(sizes))
+	for name, size := range sizes {
+		path := filepath.Join(root, name)
+		if err := os.WriteFile(path, make([]byte, size), 0644); err != nil {
+			t.Fatal(err)
+		}
+		f, err := NewFile(path, root, git)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dxs = append(dxs, f)
+	}
+	return dxs
+}
+
+func names(dxs []DirEntryX) []string {
+	ns := make([]string, len(dxs))
+	for i, de := range dxs {
+		ns[i] = de.Name()
+	}
+	return ns
+}
+
+func TestSortKeysSortTie(t *testing.T) {
+	assert := assert.New(t)
+
+	dxs := newTestFiles(t, map[string]int{"a": 1, "b": 2, "c": 2, "d": 1})
+	for _, spec := range []string{"size:desc", "size:desc,name"} {
+		keys, err := ParseSortKeys(spec)
+		assert.NoError(err)
+		keys.Sort(dxs)
+		assert.Equal([]string{"b", "c", "a", "d"}, names(dxs), spec)
+	}
+}
+
+func TestParseSortKeys(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		spec  string
+		want  SortKeys
+		isErr bool
+	}{
+		{"size", SortKeys{SortBySize}, false},
+		{"size:desc", SortKeys{SortBySizeR}, false},
+		{"sizer", SortKeys{SortBySizeR}, false},
+		{"natural", SortKeys{SortByVersion}, false},
+		{"naturalr", SortKeys{SortByVersionR}, false},
+		{"modified:desc", SortKeys{SortByMTimeR}, false},
+		{" ext , size:desc , name ", SortKeys{SortByExtension, SortBySizeR, SortByName}, false},
+		{"", nil, true},
+		{" , ", nil, true},
+		{"bogus", nil, true},
+		{"size:up", nil, true},
+	}
+	for _, tt := range tests {
+		keys, err := ParseSortKeys(tt.spec)
+		if tt.isErr {
+			assert.Error(err, tt.spec)
+			continue
+		}
+		assert.NoError(err, tt.spec)
+		assert.Equal(tt.want, keys, tt.spec)
+	}
+}
+
+func TestChainLessFuncs(t *testing.T) {
+	assert := assert.New(t)
+
+	dxs := newTestFiles(t, map[string]int{"a": 2, "b": 1, "c": 2, "d": 1})
+	tests := []struct {
+		less ByLessFunc
+		want []string
+	}{
+		{ChainLessFuncs(BySizeLessFunc, ByNameLessFunc), []string{"b", "d", "a", "c"}},
+		{ChainLessFuncs(BySizeLessFunc, ByNameLessFunc.Reverse()), []string{"d", "b", "c", "a"}},
+		{ChainLessFuncs(BySizeLessFunc.Reverse(), ByNameLessFunc), []string{"a", "c", "b", "d"}},
+	}
+	for _, tt := range tests {
+		sort.Slice(dxs, func(i, j int) bool { return tt.less(dxs[i], dxs[j]) })
+		assert.Equal(tt.want, names(dxs))
+	}
+
+	none := ChainLessFuncs()
+	assert.False(none(dxs[0], dxs[1]))
+	assert.False(none(dxs[1], dxs[0]))
+}
@@ -0,0 +1,246 @@
+package vfs
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/shyang107/paw"
+	"github.com/shyang107/paw/cast"
+	"github.com/sirupsen/logrus"
+)
+
+// Stream scans v directory by directory and views each directory as soon as it is scanned, instead of viewing after BuildFS; it is used instead of BuildFS and View. The entries are viewed in the level view if VFSOption.ViewType has ViewLevel, otherwise in the list view.
+//
+// Because the whole tree is not known in advance, the widths of fields are fitted to the directories viewed so far, the root head has no total size, and the git status of a directory is not summarized from its entries.
+func (v *VFS) Stream(w io.Writer) error {
+	paw.Logger.WithFields(logrus.Fields{"View type": v.opt.ViewType}).Debug("stream...")
+
+	w = paw.NewColorWriter(w, v.opt.ColorMode)
+	var (
+		rootdir = v.RootDir()
+		wdstty  = sttyWidth - 2
+		s       = &streamer{
+			w:       w,
+			root:    rootdir.Path(),
+			opt:     v.opt,
+			vfields: v.opt.ViewFields,
+			isLevel: v.opt.ViewType&ViewLevel != 0,
+			wdstty:  wdstty,
+			idxmap:  make(map[string]string),
+		}
+		ancestors []inodeKey
+	)
+	s.hasX, s.isViewNoDirs, s.isViewNoFiles = v.hasX_NoDir_NoFiles()
+	if s.isLevel {
+		s.vfields |= ViewFieldNo
+	}
+	if key, ok := inodeKeyOf(rootdir.info); ok {
+		ancestors = append(ancestors, key)
+	}
+
+	fmt.Fprintf(w, "%s%s\n",
+		paw.Cpmpt.Sprint("Root directory: "),
+		PathTo(rootdir, &PathToOption{true, paw.EXAColorAttributes["bgpmpt"], PRTPathToLink}))
+	FprintBanner(w, "", "=", wdstty)
+
+	s.dir(rootdir, 0, ancestors)
+
+	FprintBanner(w, "", "=", wdstty)
+	fmt.Fprintln(w, totalTextSummary("", s.tnd, s.tnf, s.tsize, s.tt, wdstty))
+
+	ViewFieldName.SetWidth(paw.StringWidth(ViewFieldName.Name()))
+	return nil
+}
+
+// IsStreamable returns true if t can be viewed by Stream, i.e. the list or level view (with extended attributes, no directories or no files)
+func (t ViewType) IsStreamable() bool {
+	return t&(ViewList|ViewLevel) != 0 &&
+		t&(ViewTree|ViewTable|ViewClassify|ViewGrid|_ViewList) == 0
+}
+
+// streamer is the state of Stream
+type streamer struct {
+	w                                 io.Writer
+	root                              string
+	opt                               *VFSOption
+	vfields                           ViewField
+	hasX, isViewNoDirs, isViewNoFiles bool
+	isLevel                           bool
+	wdstty                            int
+	tnd, tnf                          int
+	tsize                             int64
+	tt                                *textTotal
+	idxmap                            map[string]string
+	// isSummarized is true if the last viewed directory ends with its summary
+	isSummarized bool
+}
+
+// dir scans directory cur at `level` (0 is root), views its entries and then descends into its sub-directories; chain is the inode keys of directories from root to cur and used to detect loops of symbolic links.
+func (s *streamer) dir(cur *Dir, level int, chain []inodeKey) {
+	var (
+		w        = s.w
+		rp       = cur.RelPath()
+		followed = scanDir(cur, s.root, chain)
+		pad      string
+	)
+	if s.isLevel {
+		pad = paw.Spaces(level * 3)
+	}
+	if level > 0 {
+		if s.isSummarized {
+			FprintBanner(w, "", "-", s.wdstty)
+			s.isSummarized = false
+		}
+		if s.isLevel {
+			cidx := " [" + paw.Cvalue.Sprint(s.idxmap[rp]) + "] "
+			cur.FprintlnRelPathC(w, pad+paw.Cfield.Sprintf("L%d", level)+cidx, false)
+		} else {
+			cur.FprintlnRelPathC(w, "", false)
+		}
+	}
+	if len(cur.errors) > 0 {
+		cur.FprintErrors(os.Stderr, pad, false)
+	}
+
+	des, _ := cur.ReadDirAll()
+	if len(des) > 0 {
+		s.entries(cur, des, pad)
+	}
+
+	if !s.isDescend(level + 1) {
+		return
+	}
+	for _, de := range des {
+		next, ok := de.(*Dir)
+		if !ok {
+			continue
+		}
+		nchain := chain
+		if key, ok := followed[next.Name()]; ok {
+			nchain = append(chain[:len(chain):len(chain)], key)
+		} else if key, ok := inodeKeyOf(next.info); ok {
+			nchain = append(chain[:len(chain):len(chain)], key)
+		}
+		s.dir(next, level+1, nchain)
+		// release the scanned entries
+		next.children = make(map[string]DirEntryX)
+	}
+}
+
+// entries views the entries, des, of directory cur
+func (s *streamer) entries(cur *Dir, des []DirEntryX, pad string) {
+	var (
+		w            = s.w
+		vfields      = s.vfields
+		fields       = vfields.Fields()
+		curnd, curnf int
+		size         int64
+		nitems       = len(des)
+	)
+	if s.isViewNoDirs || s.isViewNoFiles {
+		for _, de := range des {
+			isSkipViewItem(de, s.isViewNoDirs, s.isViewNoFiles, &nitems, &curnd, &curnf, &size)
+		}
+		if curnd+curnf == 0 {
+			return
+		}
+		curnd, curnf, size = 0, 0, 0
+	}
+
+	wdno := ViewFieldNo.Width()
+	vfields.ModifyWidths(cur)
+	if s.isLevel {
+		nd, nf, _ := cur.NItems(false)
+		ViewFieldNo.SetWidth(paw.MaxInt(wdno, GetMaxWidthOf(s.tnd+nd, s.tnf+nf)+1))
+		ViewFieldName.SetWidth(GetViewFieldNameWidthOf(vfields.Fields()) - len(pad))
+	}
+
+	fmt.Fprintf(w, "%s%v\n", pad, vfields.GetHead(paw.Chdp))
+	sec := newSection(w, s.opt.Grouping, pad, s.wdstty)
+	for _, de := range des {
+		if isSkipViewItem(de, s.isViewNoDirs, s.isViewNoFiles, &nitems, &curnd, &curnf, &size) {
+			continue
+		}
+		sec.add(de)
+		if s.isLevel {
+			var sidx string
+			if de.IsDir() {
+				sidx = "D" + cast.ToString(s.tnd+curnd)
+				s.idxmap[de.RelPath()] = sidx
+			} else {
+				sidx = "F" + cast.ToString(s.tnf+curnf)
+			}
+			ViewFieldNo.SetValue(sidx)
+		}
+		fmt.Fprintf(w, "%s%v\n", pad, vfields.RowStringFC(de, fields))
+		if s.hasX {
+			for _, row := range vfields.XattibutesRowsSC(de, s.opt.IsXattrValue) {
+				fmt.Fprintf(w, "%s%s\n", pad, row)
+			}
+		}
+	}
+	sec.end()
+	s.tnd += curnd
+	s.tnf += curnf
+	s.tsize += size
+	// the sub-directories of cur are not scanned yet, so the totals of text files are summed up directory by directory
+	tt := cur.textTotal(false)
+	if tt != nil {
+		if s.tt == nil {
+			s.tt = new(textTotal)
+		}
+		s.tt.ntexts += tt.ntexts
+		s.tt.lines += tt.lines
+		s.tt.words += tt.words
+	}
+	if s.opt.Depth != 0 {
+		fmt.Fprintln(w, dirTextSummary(pad, curnd, curnf, size, tt, s.wdstty))
+		s.isSummarized = true
+	}
+}
+
+// isDescend returns true if the directories at `level` are scanned under VFSOption.Depth
+func (s *streamer) isDescend(level int) bool {
+	switch {
+	case s.opt.Depth == 0:
+		return false
+	case s.opt.Depth < 0:
+		return true
+	}
+	return level < s.opt.Depth
+}
+
+// scanDir adds the entries of directory cur (not recursively) like as walkVFS, and returns the inode keys of the followed symbolic links of directories by name; chain is the inode keys of directories from root to cur.
+func scanDir(cur *Dir, root string, chain []inodeKey) map[string]inodeKey {
+	var (
+		followed = make(map[string]inodeKey)
+	)
+	if cur.children == nil {
+		cur.children = make(map[string]DirEntryX)
+	}
+	des, err := os.ReadDir(cur.Path())
+	if err != nil {
+		cur.AddErrors(&fs.PathError{
+			Op:   "ReadDir",
+			Path: cur.RelPath(),
+			Err:  err,
+		})
+	}
+	for _, d := range des {
+		relpath := filepath.Join(cur.RelPath(), d.Name())
+		child, key, isFollow, _ := newDirEntryX(cur, d, root, relpath, "scanDir", func() []inodeKey {
+			return chain
+		})
+		if child == nil {
+			continue
+		}
+		cur.children[d.Name()] = child
+		if isFollow {
+			followed[d.Name()] = key
+		}
+	}
+	return followed
+}
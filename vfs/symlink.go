@@ -0,0 +1,137 @@
+package vfs
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"github.com/shyang107/paw"
+)
+
+// FollowMode decides which symbolic links to directories are descended during building VFS
+type FollowMode int
+
+const (
+	// FollowNone never follows symbolic links, even if the root is a symbolic link (like as `find -P`)
+	FollowNone FollowMode = iota + 1
+	// FollowCommandLine only follows the root given on the command line (like as `ls -H` or `find -H`), this is default.
+	FollowCommandLine
+	// FollowAll follows all symbolic links during building VFS (like as `ls -L` or `find -L`)
+	FollowAll
+)
+
+var (
+	// ErrSymlinkLoop reports that a symbolic link points to one of its ancestor directories
+	ErrSymlinkLoop = errors.New("symbolic link loop")
+	// ErrSymlinkNotFollowed reports that the root is a symbolic link but FollowNone is used
+	ErrSymlinkNotFollowed = errors.New("symbolic link is not followed")
+
+	FollowModeNames = map[FollowMode]string{
+		FollowNone:        "none",
+		FollowCommandLine: "cmdline",
+		FollowAll:         "all",
+	}
+
+	FollowModeShortNames = map[string]FollowMode{
+		"none":    FollowNone,
+		"cmdline": FollowCommandLine,
+		"all":     FollowAll,
+	}
+)
+
+func (f FollowMode) String() string {
+	if name, ok := FollowModeNames[f]; ok {
+		return name
+	}
+	return "Unknown"
+}
+
+// IsOk returns true for effective and otherwise not. In genernal, use it in checking.
+func (f FollowMode) IsOk() bool {
+	paw.Logger.Debug("checking FollowMode..." + paw.Caller(1))
+	_, ok := FollowModeNames[f]
+	return ok
+}
+
+// inodeKey identifies a directory by device and inode number
+type inodeKey struct {
+	dev uint64
+	ino uint64
+}
+
+func inodeKeyOf(info FileInfo) (key inodeKey, ok bool) {
+	if info == nil {
+		return key, false
+	}
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return key, false
+	}
+	return inodeKey{dev: uint64(stat.Dev), ino: uint64(stat.Ino)}, true
+}
+
+// chainOf returns the inode keys of all directories from root to the parent directory of path. ancestors are the keys from root to the top directory of the current walk, and dirs are the directories created during the current walk.
+func chainOf(ancestors []inodeKey, dirs map[string]*Dir, path string) []inodeKey {
+	chain := make([]inodeKey, len(ancestors))
+	copy(chain, ancestors)
+	parents := []string{}
+	for dir := filepath.Dir(path); dir != "."; dir = filepath.Dir(dir) {
+		parents = append(parents, dir)
+	}
+	for i := len(parents) - 1; i >= 0; i-- {
+		if d, ok := dirs[parents[i]]; ok {
+			if key, ok := inodeKeyOf(d.info); ok {
+				chain = append(chain, key)
+			}
+		}
+	}
+	return chain
+}
+
+func hasInodeKey(chain []inodeKey, key inodeKey) bool {
+	for _, k := range chain {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// followSymlink creates the entry of symbolic link fpath. If the link points to a directory and it's not one of ancestors in chain, a *Dir is returned and isFollow is true, so that caller can descend into it.
+//
+// Broken links, loops and unreadable targets are returned as error, but de is still valid and shown as the link itself.
+func followSymlink(fpath, root string, git *GitStatus, opt *VFSOption, chain []inodeKey) (de DirEntryX, key inodeKey, isFollow bool, err error) {
+	// linkEntry returns the entry of link itself, or nil if it fails to be created
+	linkEntry := func() DirEntryX {
+		if f, err := newFile(fpath, root, git, opt); err == nil {
+			return f
+		}
+		return nil
+	}
+	info, err := os.Stat(fpath)
+	if err != nil {
+		return linkEntry(), key, false, err
+	}
+	if !info.IsDir() {
+		f, err := newFile(fpath, root, git, opt)
+		if err != nil {
+			return nil, key, false, err
+		}
+		return f, key, false, nil
+	}
+	key, ok := inodeKeyOf(info)
+	if ok && hasInodeKey(chain, key) {
+		return linkEntry(), key, false, ErrSymlinkLoop
+	}
+	dir, err := NewDir(fpath, root, git, opt)
+	if err != nil {
+		return linkEntry(), key, false, err
+	}
+	return dir, key, true, nil
+}
+
+func isSymlinkEntry(d DirEntry) bool {
+	return d.Type()&fs.ModeSymlink != 0
+}
@@ -60,9 +60,20 @@ func NewVFS(root string, opt *VFSOption) (*VFS, error) {
 
 	opt.Check()
 
+	if opt.Follow == FollowNone {
+		if linfo, err := os.Lstat(root); err == nil && linfo.Mode()&os.ModeSymlink != 0 {
+			return nil, &fs.PathError{
+				Op:   "NewVFS",
+				Path: root,
+				Err:  ErrSymlinkNotFollowed,
+			}
+		}
+	}
+
 	paw.Logger.WithFields(logrus.Fields{
 		"Depth":          opt.Depth,
 		"IsForceRecurse": opt.IsForceRecurse,
+		"Follow":         opt.Follow,
 		"Grouping":       opt.Grouping,
 		"ByField":        opt.ByField,
 		"Skips":          opt.Skips,
@@ -156,58 +167,61 @@ func (v *VFS) BuildFS() error {
 }
 
 func buildVFSwalk(cur *Dir, root string) error {
+	var ancestors []inodeKey
+	if key, ok := inodeKeyOf(cur.info); ok {
+		ancestors = append(ancestors, key)
+	}
+	return walkVFS(cur, root, ".", ancestors)
+}
+
+// walkVFS walks the directory base (relative path with respect to root) and adds all entries into top. If VFSOption.Follow is FollowAll, walkVFS descends into symbolic links of directories recursively; ancestors are the inode keys of directories from root to top and used to detect loops.
+func walkVFS(top *Dir, root, base string, ancestors []inodeKey) error {
 	var (
-		this = cur
-		git  = cur.git
-		skip = cur.opt.Skips
+		opt  = top.opt
 		dirs = make(map[string]*Dir)
 		ok   bool
 	)
-	dirs["."] = cur
-	rfs := os.DirFS(root)
+	dirs["."] = top
+	rfs := os.DirFS(filepath.Join(root, base))
 	err := fs.WalkDir(rfs, ".", func(path string, d fs.DirEntry, err error) error {
-		dir := filepath.Dir(path)
-		level := len(strings.Split(path, "/"))
-		if cur.opt.Depth == 0 && level > 1 {
+		relpath := filepath.Join(base, path)
+		level := len(strings.Split(relpath, "/"))
+		if opt.Depth == 0 && level > 1 {
 			return fs.SkipDir
 		}
-		if !cur.opt.IsForceRecurse &&
-			cur.opt.Depth > 0 &&
-			level > cur.opt.Depth ||
+		if !opt.IsForceRecurse &&
+			opt.Depth > 0 &&
+			level > opt.Depth ||
 			err == fs.SkipDir {
 			return nil
 		}
+		this := dirs[filepath.Dir(path)]
+		if this == nil {
+			this = top
+		}
 		if err != nil {
 			this.AddErrors(&fs.PathError{
 				Op:   "WalkDir",
-				Path: path,
+				Path: relpath,
 				Err:  err,
 			})
 			// paw.Error.Printf("WalkDirFunc[dir %q, path %q]: %v", dir, path, err)
 			return nil
 		}
+		if path == "." {
+			return nil
+		}
 
-		if skip.IsSkip(d) {
-			if path != "." && d.IsDir() {
+		child, key, isFollow, isSkip := newDirEntryX(this, d, root, relpath, "buildVFSwalk", func() []inodeKey {
+			return chainOf(ancestors, dirs, path)
+		})
+		if isSkip {
+			if d.IsDir() {
 				return fs.SkipDir
 			}
 			return nil
 		}
-
-		var child DirEntryX
-		fpath := filepath.Join(root, path)
-		if !d.IsDir() {
-			child, err = NewFile(fpath, root, git)
-		} else {
-			child, err = NewDir(fpath, root, git, cur.opt)
-		}
-		if err != nil {
-			this.AddErrors(&fs.PathError{
-				Op:   "buildVFSwalk",
-				Path: path,
-				Err:  err,
-			})
-			// paw.Error.Printf("[dir:%q, path %q]: %v", dir, path, err)
+		if child == nil {
 			return nil
 		}
 		if d.IsDir() {
@@ -215,18 +229,73 @@ func buildVFSwalk(cur *Dir, root string) error {
 				dirs[path] = child.(*Dir)
 			}
 		}
-		dir = filepath.Dir(path)
-		this = dirs[dir]
 		this.children[d.Name()] = child
+
+		if isFollow && opt.Depth != 0 &&
+			(opt.IsForceRecurse || opt.Depth < 0 || level < opt.Depth) {
+			next := child.(*Dir)
+			chain := append(chainOf(ancestors, dirs, path), key)
+			if err := walkVFS(next, root, relpath, chain); err != nil {
+				next.AddErrors(err)
+			}
+		}
 		return nil
 	})
 	if err != nil {
-		cur.AddErrors(err)
+		top.AddErrors(err)
 		return err
 	}
 	return nil
 }
 
+// newDirEntryX creates the entry of d in directory `this`, where relpath is the relative path of d with respect to root. If VFSOption.Follow is FollowAll, a symbolic link is followed (see followSymlink), and chainOf returns the inode keys of directories from root to `this`. Errors are added into `this` with the operation `op`.
+//
+// isSkip is true if d is skipped by VFSOption.Skips; child is nil if d is skipped or fails to be created.
+func newDirEntryX(this *Dir, d fs.DirEntry, root, relpath, op string, chainOf func() []inodeKey) (child DirEntryX, key inodeKey, isFollow, isSkip bool) {
+	var (
+		git   = this.git
+		opt   = this.opt
+		skip  = opt.Skips
+		fpath = filepath.Join(root, relpath)
+		err   error
+	)
+	if skip.IsSkip(d) {
+		return nil, key, false, true
+	}
+	switch {
+	case isSymlinkEntry(d) && opt.Follow == FollowAll:
+		child, key, isFollow, err = followSymlink(fpath, root, git, opt, chainOf())
+		if err != nil {
+			this.AddErrors(&fs.PathError{
+				Op:   "follow",
+				Path: relpath,
+				Err:  err,
+			})
+			if child == nil {
+				return nil, key, false, false
+			}
+			err = nil
+		}
+	case !d.IsDir():
+		child, err = newFile(fpath, root, git, opt)
+	default:
+		child, err = NewDir(fpath, root, git, opt)
+	}
+	if err != nil || child == nil {
+		this.AddErrors(&fs.PathError{
+			Op:   op,
+			Path: relpath,
+			Err:  err,
+		})
+		return nil, key, false, false
+	}
+	// check again, some Skipers need the information of DirEntryX (e.g. extended attributes)
+	if skip.IsSkip(child) {
+		return nil, key, false, true
+	}
+	return child, key, isFollow, false
+}
+
 func buildVFS(cur *Dir, root string, level int) {
 	var (
 		dpath = cur.Path()
@@ -268,7 +337,7 @@ func buildVFS(cur *Dir, root string, level int) {
 		// xattrs, _ := GetXattr(path)
 		var child DirEntryX
 		if !d.IsDir() {
-			child, err = NewFile(path, root, git)
+			child, err = newFile(path, root, git, cur.opt)
 		} else {
 			child, err = NewDir(path, root, git, cur.opt)
 		}
@@ -10,7 +10,16 @@ import (
 )
 
 // View excutes view operation of VFS and all needed arguments to view in VFS.opt.
+//
+// If VFS.opt.ViewName is not empty, the view registered by RegisterView is used. The escape sequences of color are removed if w is not colored under VFS.opt.ColorMode.
 func (v *VFS) View(w io.Writer) {
+	w = paw.NewColorWriter(w, v.opt.ColorMode)
+	if len(v.opt.ViewName) > 0 {
+		if err := v.ViewBy(w, v.opt.ViewName); err != nil {
+			paw.Logger.Error(err)
+		}
+		return
+	}
 	if view, ok := ViewTypeFuncs[v.opt.ViewType]; ok {
 		view(w, v)
 	} else {
@@ -65,7 +74,7 @@ func (v *VFS) Dump(w io.Writer) {
 	hasX, isViewNoDirs, isViewNoFiles := v.hasX_NoDir_NoFiles()
 	_dump(w, cur, root, 0, head, hasX, isViewNoDirs, isViewNoFiles, &tnd, &tnf, &tsize)
 	// color.NoColor = paw.NoColor
-	fmt.Fprintln(w, totalSummary("", tnd, tnf, tsize, wdstty))
+	fmt.Fprintln(w, totalTextSummary("", tnd, tnf, tsize, cur.textTotal(true), wdstty))
 }
 
 func _dump(w io.Writer, cur *Dir, root string, level int, head string, hasX, isViewNoDirs, isViewNoFiles bool, nd, nf *int, size *int64) {
@@ -107,7 +116,7 @@ func _dump(w io.Writer, cur *Dir, root string, level int, head string, hasX, isV
 		// xattrs, _ := GetXattr(path)
 		var child DirEntryX
 		if !de.IsDir() {
-			child, err = NewFile(path, root, git)
+			child, err = newFile(path, root, git, cur.opt)
 		} else {
 			child, err = NewDir(path, root, git, cur.opt)
 		}
@@ -127,7 +136,7 @@ func _dump(w io.Writer, cur *Dir, root string, level int, head string, hasX, isV
 			}
 		}
 		cur.children[de.Name()] = child
-		_dumpPrint(w, child, cur.opt.ViewFields, hasX)
+		_dumpPrint(w, child, cur.opt.ViewFields, hasX, cur.opt.IsXattrValue)
 		// paw.Logger.WithFields(logrus.Fields{
 		// 	"name":  child.Name(),
 		// 	"IsDir": child.IsDir(),
@@ -147,16 +156,16 @@ func _dump(w io.Writer, cur *Dir, root string, level int, head string, hasX, isV
 	}
 }
 
-func _dumpPrint(w io.Writer, de DirEntryX, vfields ViewField, hasX bool) {
+func _dumpPrint(w io.Writer, de DirEntryX, vfields ViewField, hasX, isValue bool) {
 	cmeta := vfields.RowStringXNameC(de)
 	fmt.Fprintf(w, "%v ", cmeta)
 	cname := PathTo(de, &PathToOption{true, nil, PRTRelPathToLink})
 	fmt.Fprintf(w, "%v\n", cname)
-	xs := de.Xattibutes()
+	xsymbs, xs := extendedAttrs(de, isValue)
 	if hasX && len(xs) > 0 {
 		pad := paw.Spaces(paw.StringWidth(paw.StripANSI(cmeta)))
-		for _, x := range xs {
-			x = paw.Cxbp.Sprint("@ ") + paw.Cxap.Sprint(x)
+		for i, x := range xs {
+			x = paw.Cxbp.Sprint(xsymbs[i]) + paw.Cxap.Sprint(x)
 			fmt.Fprintln(w, pad, x)
 		}
 	}
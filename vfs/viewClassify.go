@@ -88,8 +88,9 @@ func viewClassify(w io.Writer, rootdir *Dir, isViewNoDirs, isViewNoFiles bool) {
 				continue
 			}
 			count++
-			name := de.Name()
-			cname := de.LSColor().Sprint(strings.TrimSpace(name))
+			qname := quote(de, de.Name())
+			name := iconPrefix(de) + qname
+			cname := iconC(de) + hyperlinkC(de, de.LSColor().Sprint(strings.TrimSpace(qname)))
 			xattrs := de.Xattibutes()
 			if xattrs == nil {
 				names = append(names, name+"?")
@@ -142,7 +143,7 @@ func viewClassify(w io.Writer, rootdir *Dir, isViewNoDirs, isViewNoFiles bool) {
 
 	fmt.Fprintln(w)
 	// FprintBanner(w, "", "=", wdstty)
-	fmt.Fprintln(w, totalSummary("", tnd, tnf, tsize, wdstty))
+	fmt.Fprintln(w, totalTextSummary("", tnd, tnf, tsize, rootdir.textTotal(true), wdstty))
 	// rootdir.FprintlnSummaryC(w, "", wdstty, true)
 }
 
@@ -0,0 +1,201 @@
+package vfs
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/shyang107/paw"
+	"github.com/sirupsen/logrus"
+)
+
+// GridFieldShortNames maps the short names of fields to the field shown in each cell of grid view (see VFSOption.GridField)
+var GridFieldShortNames = map[string]ViewField{
+	"inode":  ViewFieldINode,
+	"size":   ViewFieldSize,
+	"blocks": ViewFieldBlocks,
+	"git":    ViewFieldGit,
+}
+
+// gridCell is a cell of grid view
+type gridCell struct {
+	field, cfield string
+	name, cname   string
+}
+
+// VFSViewGrid prints out VFS in grid view: names are laid out in columns fitting the width of terminal (like as `ls -C`), or in rows if VFSOption.IsGridAcross (like as `ls -x`); each cell can include a compact field before name (VFSOption.GridField).
+func VFSViewGrid(w io.Writer, v *VFS) {
+	paw.Logger.WithFields(logrus.Fields{"View type": v.opt.ViewType}).Debug("view...")
+
+	_, isViewNoDirs, isViewNoFiles := v.hasX_NoDir_NoFiles()
+
+	viewGrid(w, v.RootDir(), v.opt.GridField, v.opt.IsGridAcross, isViewNoDirs, isViewNoFiles)
+}
+
+func viewGrid(w io.Writer, rootdir *Dir, field ViewField, isAcross, isViewNoDirs, isViewNoFiles bool) {
+	var (
+		wdstty       = sttyWidth - 2
+		_, _, nitems = rootdir.NItems(true)
+		crootpath    = PathTo(rootdir, &PathToOption{true, nil, PRTPathToLink})
+		tnd, tnf     int
+		tsize        int64
+		count        int
+	)
+
+	fmt.Fprintln(w, "Root ["+crootpath+"]:")
+
+	for _, rp := range rootdir.relpaths {
+		if rootdir.opt.IsRelPathNotView(rp) {
+			continue
+		}
+		paw.Logger.WithFields(logrus.Fields{"rp": rp}).Trace("getDir")
+		cur, err := rootdir.getDir(rp)
+		if err != nil {
+			paw.Logger.WithFields(logrus.Fields{"rp": rp}).Fatal(err)
+		}
+
+		if rp != "." {
+			cur.FprintlnRelPathC(w, "", false)
+		}
+
+		if len(cur.errors) > 0 {
+			cur.FprintErrors(os.Stderr, "", false)
+		}
+
+		des, _ := cur.ReadDirAll()
+		if len(des) < 1 {
+			continue
+		}
+
+		var (
+			curnd, curnf int
+			size         int64
+			cells        = make([]gridCell, 0, len(des))
+		)
+		for _, de := range des {
+			if isSkipViewItem(de, isViewNoDirs, isViewNoFiles, &nitems, &curnd, &curnf, &size) {
+				continue
+			}
+			count++
+			cells = append(cells, newGridCell(de, field))
+		}
+		fprintGrid(w, cells, wdstty, isAcross)
+
+		tnd += curnd
+		tnf += curnf
+		tsize += size
+		if count < nitems {
+			fmt.Fprintln(w)
+		}
+		if rootdir.opt.Depth == 0 {
+			break
+		}
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, totalTextSummary("", tnd, tnf, tsize, rootdir.textTotal(true), wdstty))
+}
+
+func newGridCell(de DirEntryX, field ViewField) gridCell {
+	qname := quote(de, de.Name())
+	cell := gridCell{name: iconPrefix(de) + qname}
+	if de.IsDir() {
+		cell.cname = paw.Cdip.Sprint(qname)
+	} else {
+		cell.cname = de.LSColor().Sprint(qname)
+	}
+	cell.cname = iconC(de) + hyperlinkC(de, cell.cname)
+	if field != 0 {
+		cell.field = strings.TrimSpace(de.Field(field))
+		cell.cfield = paw.TrimSpaceANSI(de.FieldC(field))
+	}
+	return cell
+}
+
+// fprintGrid prints out cells in grid fitting wdstty; the fields of cells are right-aligned.
+func fprintGrid(w io.Writer, cells []gridCell, wdstty int, isAcross bool) {
+	if len(cells) < 1 {
+		return
+	}
+	var (
+		wdfield int
+		wds     = make([]int, len(cells))
+	)
+	for _, c := range cells {
+		wdfield = paw.MaxInt(wdfield, paw.StringWidth(c.field))
+	}
+	for i, c := range cells {
+		wds[i] = paw.StringWidth(c.name)
+		if wdfield > 0 {
+			wds[i] += wdfield + 1
+		}
+	}
+	ncols, nrows, wdcols := gridLayout(wds, wdstty, isAcross)
+	for r := 0; r < nrows; r++ {
+		var b strings.Builder
+		for c := 0; c < ncols; c++ {
+			i := gridIndex(r, c, nrows, ncols, isAcross)
+			if i >= len(cells) {
+				break
+			}
+			cell := cells[i]
+			if wdfield > 0 {
+				b.WriteString(paw.Spaces(wdfield-paw.StringWidth(cell.field)) + cell.cfield + " ")
+			}
+			b.WriteString(cell.cname)
+			if c < ncols-1 && gridIndex(r, c+1, nrows, ncols, isAcross) < len(cells) {
+				b.WriteString(paw.Spaces(wdcols[c] - wds[i]))
+			}
+		}
+		fmt.Fprintln(w, b.String())
+	}
+}
+
+// gridSpacing is the number of spaces between columns of grid
+const gridSpacing = 2
+
+// gridLayout returns the most number of columns, the number of rows and the widths of columns (including spacing) of the grid whose width is not more than wdstty; wds are the widths of cells.
+func gridLayout(wds []int, wdstty int, isAcross bool) (ncols, nrows int, wdcols []int) {
+	var (
+		n     = len(wds)
+		wdmin = wds[0]
+	)
+	for _, wd := range wds {
+		wdmin = paw.MinInt(wdmin, wd)
+	}
+	maxcols := paw.MaxInt(paw.MinInt(n, (wdstty+gridSpacing)/(wdmin+gridSpacing)), 1)
+	for nc := maxcols; nc > 1; nc-- {
+		ncols = nc
+		nrows = (n + nc - 1) / nc
+		if !isAcross {
+			// all columns are full except the last one
+			ncols = (n + nrows - 1) / nrows
+		}
+		wdcols = gridWidths(wds, nrows, ncols, isAcross)
+		if paw.SumIntA(wdcols...)-gridSpacing <= wdstty {
+			return ncols, nrows, wdcols
+		}
+	}
+	return 1, n, gridWidths(wds, n, 1, isAcross)
+}
+
+func gridWidths(wds []int, nrows, ncols int, isAcross bool) []int {
+	wdcols := make([]int, ncols)
+	for i, wd := range wds {
+		c := i / nrows
+		if isAcross {
+			c = i % ncols
+		}
+		wdcols[c] = paw.MaxInt(wdcols[c], wd+gridSpacing)
+	}
+	return wdcols
+}
+
+// gridIndex returns the index of cell at row r and column c of grid
+func gridIndex(r, c, nrows, ncols int, isAcross bool) int {
+	if isAcross {
+		return r*ncols + c
+	}
+	return c*nrows + r
+}
@@ -0,0 +1,138 @@
+package vfs
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/shyang107/paw"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVFSOptionCheckGridField(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		field ViewField
+		want  ViewField
+	}{
+		{0, 0},
+		{ViewFieldSize, ViewFieldSize},
+		{ViewFieldINode, ViewFieldINode},
+		{ViewFieldBlocks, ViewFieldBlocks},
+		{ViewFieldGit, ViewFieldGit},
+		{ViewFieldName, 0},
+		{ViewFieldSize | ViewFieldGit, 0},
+	}
+	for _, tt := range tests {
+		opt := NewVFSOption()
+		opt.GridField = tt.field
+		opt.Check()
+		assert.Equal(tt.want, opt.GridField, "GridField %d", tt.field)
+	}
+}
+
+func TestViewGridField(t *testing.T) {
+	assert := assert.New(t)
+
+	root := t.TempDir()
+	assert.NoError(os.WriteFile(filepath.Join(root, "a.txt"), bytes.Repeat([]byte("x"), 1234), 0644))
+	assert.NoError(os.WriteFile(filepath.Join(root, "b.txt"), nil, 0644))
+
+	opt := NewVFSOption()
+	opt.ViewType = ViewGrid
+	opt.GridField = ViewFieldSize
+	v, err := NewVFS(root, opt)
+	assert.NoError(err)
+	assert.NoError(v.BuildFS())
+
+	buf := new(bytes.Buffer)
+	v.View(buf)
+	out := paw.StripANSI(buf.String())
+	assert.Contains(out, "a.txt")
+	assert.Contains(out, "b.txt")
+	assert.True(strings.Contains(out, "1.2k a.txt") || strings.Contains(out, "1234 a.txt"), out)
+}
+
+func TestViewIcons(t *testing.T) {
+	assert := assert.New(t)
+
+	root := t.TempDir()
+	assert.NoError(os.WriteFile(filepath.Join(root, "main.go"), nil, 0644))
+
+	tests := []struct {
+		vt     ViewType
+		isIcon bool
+		want   string
+	}{
+		{ViewGrid, true, paw.FileIcon("main.go") + " main.go"},
+		{ViewList, true, paw.FileIcon("main.go") + " main.go"},
+		{ViewClassify, true, paw.FileIcon("main.go") + " main.go"},
+		{ViewGrid, false, "main.go"},
+	}
+	for _, tt := range tests {
+		opt := NewVFSOption()
+		opt.ViewType = tt.vt
+		opt.IsIcon = tt.isIcon
+		v, err := NewVFS(root, opt)
+		assert.NoError(err)
+		assert.NoError(v.BuildFS())
+
+		buf := new(bytes.Buffer)
+		v.View(buf)
+		out := paw.StripANSI(buf.String())
+		assert.Contains(out, tt.want, "view %v", tt.vt)
+		if !tt.isIcon {
+			assert.NotContains(out, paw.FileIcon("main.go"), "view %v", tt.vt)
+		}
+	}
+}
+
+func TestGridLayout(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		wds          []int
+		width        int
+		isAcross     bool
+		ncols, nrows int
+		wdcols       []int
+	}{
+		{[]int{1, 2, 3, 4, 1}, 80, false, 5, 1, []int{3, 4, 5, 6, 3}},
+		{[]int{1, 2, 3, 4, 1}, 10, false, 2, 3, []int{5, 6}},
+		{[]int{1, 2, 3, 4, 1}, 10, true, 2, 3, []int{5, 6}},
+		{[]int{1, 2, 3, 4, 1}, 3, false, 1, 5, []int{6}},
+		{[]int{20}, 10, false, 1, 1, []int{22}},
+	}
+	for _, tt := range tests {
+		ncols, nrows, wdcols := gridLayout(tt.wds, tt.width, tt.isAcross)
+		assert.Equal(tt.ncols, ncols, "ncols of %v in %d", tt.wds, tt.width)
+		assert.Equal(tt.nrows, nrows, "nrows of %v in %d", tt.wds, tt.width)
+		assert.Equal(tt.wdcols, wdcols, "wdcols of %v in %d", tt.wds, tt.width)
+	}
+}
+
+func TestFprintGrid(t *testing.T) {
+	assert := assert.New(t)
+
+	cells := []gridCell{}
+	for _, name := range []string{"a", "bb", "ccc", "dddd", "e"} {
+		cells = append(cells, gridCell{name: name, cname: name})
+	}
+	tests := []struct {
+		width    int
+		isAcross bool
+		want     string
+	}{
+		{80, false, "a  bb  ccc  dddd  e\n"},
+		{10, false, "a    dddd\nbb   e\nccc\n"},
+		{10, true, "a    bb\nccc  dddd\ne\n"},
+	}
+	for _, tt := range tests {
+		buf := new(bytes.Buffer)
+		fprintGrid(buf, cells, tt.width, tt.isAcross)
+		assert.Equal(tt.want, buf.String(), "width %d, across %v", tt.width, tt.isAcross)
+	}
+}
@@ -0,0 +1,311 @@
+package vfs
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/shyang107/paw"
+	"github.com/shyang107/paw/cast"
+	"github.com/sirupsen/logrus"
+)
+
+func init() {
+	RegisterView("html", RendererFunc(func(w io.Writer, v *VFS, opt *VFSOption, width int) error {
+		if opt.ViewType&ViewTable != 0 {
+			return FprintHTMLTable(w, v)
+		}
+		return FprintHTMLTree(w, v)
+	}))
+	RegisterView("htmltree", RendererFunc(func(w io.Writer, v *VFS, opt *VFSOption, width int) error {
+		return FprintHTMLTree(w, v)
+	}))
+	RegisterView("htmltable", RendererFunc(func(w io.Writer, v *VFS, opt *VFSOption, width int) error {
+		return FprintHTMLTable(w, v)
+	}))
+}
+
+// FprintHTMLTree writes the HTML document of VFS to w; directories are nested collapsible `<details>` elements and the fields of list view are shown if VFSOption.ViewType has the list view (e.g. ViewListTree).
+func FprintHTMLTree(w io.Writer, v *VFS) error {
+	paw.Logger.WithFields(logrus.Fields{"View type": v.opt.ViewType}).Debug("view...")
+	var (
+		rootdir    = v.RootDir()
+		hasList, _ = v.hasList_hasX()
+		fields     []ViewField
+		h          = newHTMLDoc()
+	)
+	if hasList {
+		fields = htmlFieldsWithoutName(rootdir.opt.ViewFields)
+	}
+	h.WriteString("<div class=\"tree\">\n<details open>\n<summary>")
+	h.WriteString(h.span(paw.Cdip, PathTo(rootdir, &PathToOption{false, nil, PRTPathToLink})))
+	h.WriteString(h.gitBadges(rootdir))
+	h.WriteString("</summary>\n")
+	h.treeDir(rootdir, fields)
+	h.WriteString("</details>\n</div>\n")
+	h.summary(rootdir)
+	return h.Fprint(w, rootdir.Path(), false)
+}
+
+func (h *htmlDoc) treeDir(cur *Dir, fields []ViewField) {
+	des, _ := cur.ReadDirAll()
+	if len(des) == 0 {
+		return
+	}
+	h.WriteString("<ul>\n")
+	for _, de := range des {
+		h.WriteString("<li>")
+		if de.IsDir() {
+			h.WriteString("<details open>\n<summary>")
+			h.treeItem(de, fields)
+			h.WriteString("</summary>\n")
+			h.treeDir(de.(*Dir), fields)
+			h.WriteString("</details>")
+		} else {
+			h.treeItem(de, fields)
+		}
+		h.WriteString("</li>\n")
+	}
+	h.WriteString("</ul>\n")
+}
+
+func (h *htmlDoc) treeItem(de DirEntryX, fields []ViewField) {
+	for _, fd := range fields {
+		h.WriteString(h.span(fd.Color(), de.Field(fd)))
+		h.WriteString(" ")
+	}
+	h.WriteString(h.nameC(de, de.Name()))
+	h.WriteString(h.gitBadges(de))
+}
+
+// FprintHTMLTable writes the HTML document of VFS to w as a table whose columns can be sorted by clicking their heads; the Name column shows the relative path of files.
+func FprintHTMLTable(w io.Writer, v *VFS) error {
+	paw.Logger.WithFields(logrus.Fields{"View type": v.opt.ViewType}).Debug("view...")
+	var (
+		rootdir                        = v.RootDir()
+		_, isViewNoDirs, isViewNoFiles = v.hasX_NoDir_NoFiles()
+		fields                         = htmlFieldsWithoutName(rootdir.opt.ViewFields)
+		h                              = newHTMLDoc()
+	)
+	h.WriteString("<table class=\"sortable\">\n<thead>\n<tr>")
+	for _, fd := range fields {
+		fmt.Fprintf(h, "<th data-type=\"%s\">%s</th>", htmlSortType(fd), html.EscapeString(fd.Name()))
+	}
+	h.WriteString("<th data-type=\"str\">Name</th><th data-type=\"str\">Git</th></tr>\n</thead>\n<tbody>\n")
+	h.tableDir(rootdir, fields, isViewNoDirs, isViewNoFiles)
+	h.WriteString("</tbody>\n</table>\n")
+	h.summary(rootdir)
+	return h.Fprint(w, rootdir.Path(), true)
+}
+
+func (h *htmlDoc) tableDir(cur *Dir, fields []ViewField, isViewNoDirs, isViewNoFiles bool) {
+	des, _ := cur.ReadDirAll()
+	for _, de := range des {
+		if (de.IsDir() && !isViewNoDirs) || (!de.IsDir() && !isViewNoFiles) {
+			h.WriteString("<tr>")
+			for _, fd := range fields {
+				fmt.Fprintf(h, "<td data-value=\"%s\">%s</td>",
+					html.EscapeString(htmlSortValue(de, fd)), h.span(fd.Color(), de.Field(fd)))
+			}
+			fmt.Fprintf(h, "<td data-value=\"%s\">%s</td>", html.EscapeString(de.RelPath()), h.nameC(de, de.RelPath()))
+			fmt.Fprintf(h, "<td data-value=\"%s\">%s</td>", html.EscapeString(htmlGitXY(de)), h.gitBadges(de))
+			h.WriteString("</tr>\n")
+		}
+		if de.IsDir() {
+			h.tableDir(de.(*Dir), fields, isViewNoDirs, isViewNoFiles)
+		}
+	}
+}
+
+// htmlFieldsWithoutName returns the fields of vfields except No., Git and Name; git status is shown as badges.
+func htmlFieldsWithoutName(vfields ViewField) []ViewField {
+	fields := []ViewField{}
+	for _, fd := range vfields.Fields() {
+		switch fd {
+		case ViewFieldNo, ViewFieldGit, ViewFieldName:
+			continue
+		}
+		fields = append(fields, fd)
+	}
+	return fields
+}
+
+// htmlSortType returns the type of sorting of field in the sortable table: "num" or "str"
+func htmlSortType(fd ViewField) string {
+	switch fd {
+	case ViewFieldINode, ViewFieldLinks, ViewFieldSize, ViewFieldBlocks,
+		ViewFieldModified, ViewFieldAccessed, ViewFieldCreated,
+		ViewFieldLines, ViewFieldWords:
+		return "num"
+	default:
+		return "str"
+	}
+}
+
+// htmlSortValue returns the value of field of de used to sort the table
+func htmlSortValue(de DirEntryX, fd ViewField) string {
+	switch fd {
+	case ViewFieldINode:
+		return cast.ToString(de.INode())
+	case ViewFieldLinks:
+		return cast.ToString(de.HDLinks())
+	case ViewFieldSize:
+		if de.IsDir() {
+			return "-1"
+		}
+		return strconv.FormatInt(de.Size(), 10)
+	case ViewFieldBlocks:
+		return cast.ToString(de.Blocks())
+	case ViewFieldModified:
+		return strconv.FormatInt(de.ModifiedTime().Unix(), 10)
+	case ViewFieldAccessed:
+		return strconv.FormatInt(de.AccessedTime().Unix(), 10)
+	case ViewFieldCreated:
+		return strconv.FormatInt(de.CreatedTime().Unix(), 10)
+	case ViewFieldLines:
+		return strconv.Itoa(textLines(de))
+	case ViewFieldWords:
+		if st := de.TextStat(); st != nil {
+			return strconv.Itoa(st.Words)
+		}
+		return "-1"
+	default:
+		return de.Field(fd)
+	}
+}
+
+// htmlGitXY returns XY of git status of de, or "" if there is no git repository
+func htmlGitXY(de DirEntryX) string {
+	if de.Git() == nil || de.Git().NoGit {
+		return ""
+	}
+	return de.XY()
+}
+
+// htmlDoc is the builder of HTML document; the colors used in the document are converted to CSS classes.
+type htmlDoc struct {
+	strings.Builder
+	classes map[string]string // class -> CSS declarations
+}
+
+func newHTMLDoc() *htmlDoc {
+	return &htmlDoc{classes: make(map[string]string)}
+}
+
+// class returns the CSS class of color c, e.g. "c-38-5-33-1", or "" if c has no attributes; prefix "b" uses the foreground color as background (for badges).
+func (h *htmlDoc) class(prefix string, c *Color) string {
+	attrs := paw.ColorAttributes(c)
+	if len(attrs) == 0 {
+		return ""
+	}
+	codes := make([]string, 0, len(attrs))
+	for _, a := range attrs {
+		codes = append(codes, strconv.Itoa(int(a)))
+	}
+	name := prefix + "-" + strings.Join(codes, "-")
+	if _, ok := h.classes[name]; !ok {
+		style := paw.NewTextStyle(attrs...)
+		if prefix == "b" {
+			style = paw.TextStyle{Bg: style.Fg, Bold: style.Bold}
+		}
+		h.classes[name] = style.CSS()
+	}
+	return name
+}
+
+// span returns the escaped text in the `<span>` of class of color c
+func (h *htmlDoc) span(c *Color, text string) string {
+	text = html.EscapeString(text)
+	if class := h.class("c", c); len(class) > 0 {
+		return "<span class=\"" + class + "\">" + text + "</span>"
+	}
+	return text
+}
+
+// nameC returns the name (or text) of de colored by GetDexLSColor; symbolic link is followed by its target.
+func (h *htmlDoc) nameC(de DirEntryX, name string) string {
+	s := h.span(GetDexLSColor(de), name)
+	if de.IsLink() {
+		s += html.EscapeString(" -> ") + h.span(paw.Clnp, de.LinkPath())
+	}
+	return s
+}
+
+// gitBadges returns the badges of git status (X and Y) of de, or "" if it is unchanged or there is no git repository.
+func (h *htmlDoc) gitBadges(de DirEntryX) string {
+	xy := htmlGitXY(de)
+	if len(xy) != 2 || xy == "--" {
+		return ""
+	}
+	var b strings.Builder
+	for i, title := range []string{"staging", "worktree"} {
+		code := GitStatusCode(xy[i])
+		fmt.Fprintf(&b, " <span class=\"git %s\" title=\"%s\">%s</span>",
+			h.class("b", code.Color()), title, html.EscapeString(code.String()))
+	}
+	return b.String()
+}
+
+func (h *htmlDoc) summary(rootdir *Dir) {
+	summary := strings.TrimSpace(paw.StripANSI(rootdir.SummaryC("", sttyWidth, true)))
+	h.WriteString("<p class=\"summary\">" + html.EscapeString(summary) + "</p>\n")
+}
+
+// Fprint writes the whole document, including head, styles and script of sortable table if isSortable, to w.
+func (h *htmlDoc) Fprint(w io.Writer, title string, isSortable bool) error {
+	var b strings.Builder
+	b.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	b.WriteString("<title>" + html.EscapeString(title) + "</title>\n<style>\n")
+	b.WriteString(htmlStyle)
+	classes := make([]string, 0, len(h.classes))
+	for class := range h.classes {
+		classes = append(classes, class)
+	}
+	sort.Strings(classes)
+	for _, class := range classes {
+		fmt.Fprintf(&b, ".%s{%s}\n", class, h.classes[class])
+	}
+	b.WriteString("</style>\n</head>\n<body>\n")
+	b.WriteString(h.String())
+	if isSortable {
+		b.WriteString("<script>\n" + htmlSortScript + "</script>\n")
+	}
+	b.WriteString("</body>\n</html>\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+const htmlStyle = `:root{--fg:#d0d0d0;--bg:#1c1c1c}
+body{font-family:monospace;color:var(--fg);background-color:var(--bg)}
+.tree ul{list-style:none;margin:0;padding-left:1.5em}
+.tree details>summary{cursor:pointer}
+.tree li>span:first-child{white-space:pre}
+table.sortable{border-collapse:collapse}
+table.sortable th{cursor:pointer;text-align:left;border-bottom:1px solid var(--fg);padding:0 .5em}
+table.sortable th[data-order=asc]::after{content:" \25b4"}
+table.sortable th[data-order=desc]::after{content:" \25be"}
+table.sortable td{padding:0 .5em;white-space:pre}
+.git{display:inline-block;min-width:1em;margin-left:.2em;padding:0 .2em;border-radius:.3em;text-align:center;color:var(--bg)}
+.summary{margin-top:1em;opacity:0.8}
+`
+
+const htmlSortScript = `document.querySelectorAll("table.sortable th").forEach(function (th, col) {
+	th.addEventListener("click", function () {
+		var tbody = th.closest("table").tBodies[0];
+		var rows = Array.prototype.slice.call(tbody.rows);
+		var asc = th.dataset.order !== "asc";
+		var num = th.dataset.type === "num";
+		rows.sort(function (a, b) {
+			var x = a.cells[col].dataset.value, y = b.cells[col].dataset.value;
+			var c = num ? Number(x) - Number(y) : x.localeCompare(y);
+			return asc ? c : -c;
+		});
+		th.parentNode.querySelectorAll("th").forEach(function (h) { delete h.dataset.order; });
+		th.dataset.order = asc ? "asc" : "desc";
+		rows.forEach(function (r) { tbody.appendChild(r); });
+	});
+});
+`
@@ -89,6 +89,7 @@ func viewLevel(w io.Writer, rootdir *Dir, hasX, isViewNoDirs, isViewNoFiles bool
 			size         int64
 			vnitems      = nitems
 			head         string
+			sec          *section
 		)
 		if isViewNoDirs || isViewNoFiles {
 			for _, de := range des {
@@ -104,10 +105,12 @@ func viewLevel(w io.Writer, rootdir *Dir, hasX, isViewNoDirs, isViewNoFiles bool
 		// head := vfields.GetHeadFunc(paw.ChoseColorH)
 		head = vfields.GetHead(paw.Chdp)
 		fmt.Fprintf(w, "%s%v\n", pad, head)
+		sec = newSection(w, rootdir.opt.Grouping, pad, wdstty)
 		for _, de := range des {
 			if isSkipViewItem(de, isViewNoDirs, isViewNoFiles, &nitems, &curnd, &curnf, &size) {
 				continue
 			}
+			sec.add(de)
 			count++
 			var sidx string
 			if de.IsDir() {
@@ -122,17 +125,18 @@ func viewLevel(w io.Writer, rootdir *Dir, hasX, isViewNoDirs, isViewNoFiles bool
 			// print fields of de
 			fmt.Fprintf(w, "%v \n", vfields.RowStringC(de))
 			if hasX {
-				xrows := vfields.XattibutesRowsSC(de)
+				xrows := vfields.XattibutesRowsSC(de, rootdir.opt.IsXattrValue)
 				for _, row := range xrows {
 					fmt.Fprintf(w, "%s%s\n", pad, row)
 				}
 			}
 		}
+		sec.end()
 		tnd += curnd
 		tnf += curnf
 		tsize += size
 		if rootdir.opt.Depth != 0 {
-			fmt.Fprintln(w, dirSummary(pad, curnd, curnf, size, wdstty))
+			fmt.Fprintln(w, dirTextSummary(pad, curnd, curnf, size, cur.textTotal(false), wdstty))
 			// cur.FprintlnSummaryC(w, pad, wdstty, false)
 			if count < nitems {
 				// fmt.Fprintln(w)
@@ -148,6 +152,6 @@ func viewLevel(w io.Writer, rootdir *Dir, hasX, isViewNoDirs, isViewNoFiles bool
 	}
 
 	FprintBanner(w, "", "=", wdstty)
-	fmt.Fprintln(w, totalSummary("", tnd, tnf, tsize, wdstty))
+	fmt.Fprintln(w, totalTextSummary("", tnd, tnf, tsize, rootdir.textTotal(true), wdstty))
 	// rootdir.FprintlnSummaryC(w, "", wdstty, true)
 }
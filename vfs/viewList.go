@@ -74,6 +74,7 @@ func viewList(w io.Writer, rootdir *Dir, hasX, isViewNoDirs, isViewNoFiles bool)
 			curnd, curnf int
 			size         int64
 			vnitems      = nitems
+			sec          *section
 		)
 		if isViewNoDirs || isViewNoFiles {
 			for _, de := range des {
@@ -87,27 +88,30 @@ func viewList(w io.Writer, rootdir *Dir, hasX, isViewNoDirs, isViewNoFiles bool)
 			curnd, curnf, size, nitems = 0, 0, 0, vnitems
 		}
 		fmt.Fprintf(w, "%v\n", head)
+		sec = newSection(w, rootdir.opt.Grouping, "", wdstty)
 		for _, de := range des {
 			if isSkipViewItem(de, isViewNoDirs, isViewNoFiles, &nitems, &curnd, &curnf, &size) {
 				continue
 			}
+			sec.add(de)
 			count++
 			// print fields of de
 			// fmt.Fprintf(w, "%v\n", vfields.RowStringC(de))
 			fmt.Fprintf(w, "%v\n", vfields.RowStringFC(de, fields))
-			xrows := vfields.XattibutesRowsSC(de)
+			xrows := vfields.XattibutesRowsSC(de, rootdir.opt.IsXattrValue)
 			if hasX && len(xrows) > 0 {
 				for _, row := range xrows {
 					fmt.Fprintln(w, row)
 				}
 			}
 		}
+		sec.end()
 		tnd += curnd
 		tnf += curnf
 		tsize += size
 		if rootdir.opt.Depth != 0 {
 			// cur.FprintlnSummaryC(w, "", wdstty, false)
-			fmt.Fprintln(w, dirSummary("", curnd, curnf, size, wdstty))
+			fmt.Fprintln(w, dirTextSummary("", curnd, curnf, size, cur.textTotal(false), wdstty))
 			if count < nitems {
 				FprintBanner(w, "", "-", wdstty)
 			}
@@ -120,5 +124,5 @@ func viewList(w io.Writer, rootdir *Dir, hasX, isViewNoDirs, isViewNoFiles bool)
 
 	FprintBanner(w, "", "=", wdstty)
 	// rootdir.FprintlnSummaryC(w, "", wdstty, true)
-	fmt.Fprintln(w, totalSummary("", tnd, tnf, tsize, wdstty))
+	fmt.Fprintln(w, totalTextSummary("", tnd, tnf, tsize, rootdir.textTotal(true), wdstty))
 }
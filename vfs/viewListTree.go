@@ -24,10 +24,11 @@ func VFSViewListTree(w io.Writer, v *VFS) {
 
 func viewListTree(w io.Writer, rootdir *Dir, hasX, hasList bool) {
 	var (
-		vfields  = rootdir.opt.ViewFields
-		fields   []ViewField
-		wdstty   = sttyWidth - 2
-		roothead = GetRootHeadC(rootdir, wdstty)
+		vfields      = rootdir.opt.ViewFields
+		isXattrValue = rootdir.opt.IsXattrValue
+		fields       []ViewField
+		wdstty       = sttyWidth - 2
+		roothead     = GetRootHeadC(rootdir, wdstty)
 		// rootpath = PathToLinkC(rootdir, nil)
 		rootpath = PathTo(rootdir, &PathToOption{true, nil, PRTPathToLink})
 	)
@@ -67,11 +68,11 @@ func viewListTree(w io.Writer, rootdir *Dir, hasX, hasList bool) {
 			levelsEnded = append(levelsEnded, level)
 		}
 		if de.IsDir() {
-			vltFile(w, level, levelsEnded, edge, de, fields, hasX, hasList, wdstty)
+			vltFile(w, level, levelsEnded, edge, de, fields, hasX, isXattrValue, hasList, wdstty)
 			cur := de.(*Dir)
-			vltDir(w, level+1, levelsEnded, edge, cur, fields, hasX, hasList, wdstty)
+			vltDir(w, level+1, levelsEnded, edge, cur, fields, hasX, isXattrValue, hasList, wdstty)
 		} else {
-			vltFile(w, level, levelsEnded, edge, de, fields, hasX, hasList, wdstty)
+			vltFile(w, level, levelsEnded, edge, de, fields, hasX, isXattrValue, hasList, wdstty)
 		}
 	}
 
@@ -82,7 +83,7 @@ func viewListTree(w io.Writer, rootdir *Dir, hasX, hasList bool) {
 	// fmt.Fprintln(w, rootdir.SummaryC("", wdstty, true))
 }
 
-func vltFile(w io.Writer, level int, levelsEnded []int, edge EdgeType, de DirEntryX, fields []ViewField, hasX bool, hasList bool, wdstty int) {
+func vltFile(w io.Writer, level int, levelsEnded []int, edge EdgeType, de DirEntryX, fields []ViewField, hasX, isXattrValue bool, hasList bool, wdstty int) {
 	var (
 		padMeta = ""
 		meta    = ""
@@ -127,6 +128,7 @@ func vltFile(w io.Writer, level int, levelsEnded []int, edge EdgeType, de DirEnt
 	}
 
 	// 3. print out extended attributes
+	xsymbs, xattrs := extendedAttrs(de, isXattrValue)
 	if hasX && len(xattrs) > 0 {
 		switch edge {
 		case EdgeTypeMid:
@@ -134,10 +136,10 @@ func vltFile(w io.Writer, level int, levelsEnded []int, edge EdgeType, de DirEnt
 		case EdgeTypeEnd:
 			cedge = padMeta + paw.Spaces(IndentSize+1)
 		}
-		for _, x := range xattrs {
+		for i, x := range xattrs {
 			fmt.Fprintf(w, " %s%v%v\n",
 				cedge,
-				paw.Cxbp.Sprint("@ "),
+				paw.Cxbp.Sprint(xsymbs[i]),
 				paw.Cxap.Sprint(x))
 		}
 	}
@@ -152,7 +154,7 @@ func isEnded(levelsEnded []int, level int) bool {
 	return false
 }
 
-func vltDir(w io.Writer, level int, levelsEnded []int, edge EdgeType, cur *Dir, fields []ViewField, hasX bool, hasList bool, wdstty int) {
+func vltDir(w io.Writer, level int, levelsEnded []int, edge EdgeType, cur *Dir, fields []ViewField, hasX, isXattrValue bool, hasList bool, wdstty int) {
 	des, _ := cur.ReadDirAll()
 	if len(des) < 1 {
 		return
@@ -164,11 +166,11 @@ func vltDir(w io.Writer, level int, levelsEnded []int, edge EdgeType, cur *Dir,
 			levelsEnded = append(levelsEnded, level)
 		}
 		if de.IsDir() {
-			vltFile(w, level, levelsEnded, edge, de, fields, hasX, hasList, wdstty)
+			vltFile(w, level, levelsEnded, edge, de, fields, hasX, isXattrValue, hasList, wdstty)
 			cur := de.(*Dir)
-			vltDir(w, level+1, levelsEnded, edge, cur, fields, hasX, hasList, wdstty)
+			vltDir(w, level+1, levelsEnded, edge, cur, fields, hasX, isXattrValue, hasList, wdstty)
 		} else {
-			vltFile(w, level, levelsEnded, edge, de, fields, hasX, hasList, wdstty)
+			vltFile(w, level, levelsEnded, edge, de, fields, hasX, isXattrValue, hasList, wdstty)
 		}
 	}
 }
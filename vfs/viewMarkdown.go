@@ -0,0 +1,194 @@
+package vfs
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/shyang107/paw"
+	"github.com/shyang107/paw/tabulate"
+	"github.com/sirupsen/logrus"
+)
+
+func init() {
+	RegisterView("md", RendererFunc(func(w io.Writer, v *VFS, opt *VFSOption, width int) error {
+		if opt.ViewType&ViewTree != 0 {
+			return FprintMarkdownTree(w, v)
+		}
+		return FprintMarkdownTable(w, v)
+	}))
+}
+
+// VFSViewMarkdown prints out VFS in GitHub-flavored Markdown: a nested bullet list for the tree view, otherwise a table.
+func VFSViewMarkdown(w io.Writer, v *VFS) {
+	var err error
+	if v.opt.ViewType&ViewTree != 0 {
+		err = FprintMarkdownTree(w, v)
+	} else {
+		err = FprintMarkdownTable(w, v)
+	}
+	if err != nil {
+		paw.Logger.Error(err)
+	}
+}
+
+// FprintMarkdownTree writes VFS to w as a nested bullet list of Markdown; the fields of list view are shown as code spans if VFSOption.ViewType has the list view (e.g. ViewListTree).
+func FprintMarkdownTree(w io.Writer, v *VFS) error {
+	paw.Logger.WithFields(logrus.Fields{"View type": v.opt.ViewType}).Debug("view...")
+	var (
+		rootdir    = v.RootDir()
+		hasList, _ = v.hasList_hasX()
+		fields     []ViewField
+		b          strings.Builder
+	)
+	if hasList {
+		fields = markdownFields(rootdir.opt.ViewFields, false)
+	}
+	b.WriteString("- **" + mdEscape(PathTo(rootdir, &PathToOption{false, nil, PRTPathToLink})) + "/**")
+	b.WriteString(mdGitXY(rootdir) + "\n")
+	mdTreeDir(&b, rootdir, 1, fields)
+	b.WriteString("\n" + mdSummary(rootdir) + "\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func mdTreeDir(b *strings.Builder, cur *Dir, level int, fields []ViewField) {
+	des, _ := cur.ReadDirAll()
+	for _, de := range des {
+		b.WriteString(strings.Repeat("  ", level) + "- ")
+		if len(fields) > 0 {
+			values := make([]string, 0, len(fields))
+			for _, fd := range fields {
+				values = append(values, de.Field(fd))
+			}
+			b.WriteString(mdCode(strings.Join(values, " ")) + " ")
+		}
+		if de.IsDir() {
+			b.WriteString("**" + mdEscape(de.Name()) + "/**")
+		} else {
+			b.WriteString(mdEscape(mdName(de, de.Name())))
+		}
+		b.WriteString(mdGitXY(de) + "\n")
+		if de.IsDir() {
+			mdTreeDir(b, de.(*Dir), level+1, fields)
+		}
+	}
+}
+
+// FprintMarkdownTable writes VFS to w as a table of GitHub-flavored Markdown (see tabulate format "github"); the Name column shows the relative path of files.
+func FprintMarkdownTable(w io.Writer, v *VFS) error {
+	paw.Logger.WithFields(logrus.Fields{"View type": v.opt.ViewType}).Debug("view...")
+	var (
+		rootdir                        = v.RootDir()
+		_, isViewNoDirs, isViewNoFiles = v.hasX_NoDir_NoFiles()
+		fields                         = markdownFields(rootdir.opt.ViewFields, true)
+		heads                          = make([]string, 0, len(fields))
+		rows                           = make([][]string, 0)
+		b                              strings.Builder
+	)
+	for _, fd := range fields {
+		heads = append(heads, fd.Name())
+	}
+	rows = mdTableRows(rows, rootdir, fields, isViewNoDirs, isViewNoFiles)
+
+	b.WriteString("Root: " + mdCode(PathTo(rootdir, &PathToOption{false, nil, PRTPathToLink})) + "\n\n")
+	if len(rows) > 0 {
+		t := tabulate.Create(rows)
+		t.SetHeaders(heads)
+		t.SetAlign("left")
+		t.SetDenseMode()
+		t.SetMinPadding(2)
+		b.WriteString(t.Render("github"))
+	}
+	b.WriteString("\n" + mdSummary(rootdir) + "\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func mdTableRows(rows [][]string, cur *Dir, fields []ViewField, isViewNoDirs, isViewNoFiles bool) [][]string {
+	des, _ := cur.ReadDirAll()
+	for _, de := range des {
+		if (de.IsDir() && !isViewNoDirs) || (!de.IsDir() && !isViewNoFiles) {
+			row := make([]string, 0, len(fields))
+			for _, fd := range fields {
+				value := de.Field(fd)
+				if fd == ViewFieldName {
+					value = mdName(de, de.RelPath())
+					if de.IsDir() {
+						value += "/"
+					}
+				}
+				row = append(row, mdEscape(value))
+			}
+			rows = append(rows, row)
+		}
+		if de.IsDir() {
+			rows = mdTableRows(rows, de.(*Dir), fields, isViewNoDirs, isViewNoFiles)
+		}
+	}
+	return rows
+}
+
+// markdownFields returns the fields of vfields except No.; Git is only included in table, and Name is excluded if not isTable.
+func markdownFields(vfields ViewField, isTable bool) []ViewField {
+	fields := []ViewField{}
+	for _, fd := range vfields.Fields() {
+		switch {
+		case fd == ViewFieldNo:
+			continue
+		case !isTable && (fd == ViewFieldGit || fd == ViewFieldName):
+			continue
+		}
+		fields = append(fields, fd)
+	}
+	return fields
+}
+
+// mdName returns name of de followed by the target if de is a symbolic link
+func mdName(de DirEntryX, name string) string {
+	if de.IsLink() {
+		return name + " -> " + de.LinkPath()
+	}
+	return name
+}
+
+// mdGitXY returns the code span of git status of de with a leading space, or "" if it is unchanged or there is no git repository.
+func mdGitXY(de DirEntryX) string {
+	xy := htmlGitXY(de)
+	if len(xy) != 2 || xy == "--" {
+		return ""
+	}
+	return " " + mdCode(xy)
+}
+
+func mdSummary(rootdir *Dir) string {
+	return mdEscape(strings.TrimSpace(paw.StripANSI(rootdir.SummaryC("", sttyWidth, true))))
+}
+
+var mdReplacer = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", `*`, `\*`, `_`, `\_`, `[`, `\[`, `]`, `\]`,
+	`<`, `\<`, `>`, `\>`, `#`, `\#`, `|`, `\|`, `~`, `\~`,
+)
+
+// mdEscape escapes the characters of Markdown in s
+func mdEscape(s string) string {
+	return mdReplacer.Replace(s)
+}
+
+// mdCode returns the code span of s; the number of backticks is more than the longest run of backticks in s.
+func mdCode(s string) string {
+	n, run := 0, 0
+	for _, r := range s {
+		if r == '`' {
+			run++
+			n = paw.MaxInt(n, run)
+		} else {
+			run = 0
+		}
+	}
+	ticks := strings.Repeat("`", n+1)
+	if n > 0 {
+		return fmt.Sprintf("%s %s %s", ticks, s, ticks)
+	}
+	return ticks + s + ticks
+}
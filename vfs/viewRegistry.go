@@ -0,0 +1,108 @@
+package vfs
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Renderer renders the built VFS to w; it can be registered by RegisterView and used by name (VFSOption.ViewName or VFS.ViewBy).
+type Renderer interface {
+	// Render renders v to w with the options opt (the same as v.Option()) and the width of terminal.
+	Render(w io.Writer, v *VFS, opt *VFSOption, width int) error
+}
+
+// RendererFunc is an adapter to allow the use of ordinary functions as Renderer.
+type RendererFunc func(w io.Writer, v *VFS, opt *VFSOption, width int) error
+
+// Render calls f(w, v, opt, width).
+func (f RendererFunc) Render(w io.Writer, v *VFS, opt *VFSOption, width int) error {
+	return f(w, v, opt, width)
+}
+
+// ErrUnknownView is returned when the view has not been registered
+var ErrUnknownView = errors.New("unknown view")
+
+var (
+	viewsMu sync.RWMutex
+	views   = map[string]Renderer{}
+
+	// ViewShortNameTypes maps the names of built-in views to ViewType
+	ViewShortNameTypes = map[string]ViewType{
+		"list":     ViewList,
+		"listtree": ViewListTree,
+		"tree":     ViewTree,
+		"level":    ViewLevel,
+		"table":    ViewTable,
+		"classify": ViewClassify,
+		"grid":     ViewGrid,
+	}
+)
+
+func init() {
+	for name, vt := range ViewShortNameTypes {
+		RegisterView(name, builtinView(vt))
+	}
+}
+
+// builtinView returns the Renderer of built-in view vt, keeping the extended, no-dirs and no-files options of VFSOption.ViewType if vt supports them.
+func builtinView(vt ViewType) Renderer {
+	return RendererFunc(func(w io.Writer, v *VFS, opt *VFSOption, width int) error {
+		vtx := vt | opt.ViewType&(ViewExtended|ViewNoDirs|ViewNoFiles)
+		if _, ok := ViewTypeFuncs[vtx]; !ok {
+			vtx = vt
+		}
+		opt.ViewType = vtx
+		ViewTypeFuncs[vtx](w, v)
+		return nil
+	})
+}
+
+// RegisterView makes a view available by name (case insensitive). It panics if name is empty, renderer is nil or name is already registered (like as `sql.Register`), so call it in `init()` of the package of renderer.
+func RegisterView(name string, renderer Renderer) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if len(name) == 0 {
+		panic("vfs: RegisterView name is empty")
+	}
+	if renderer == nil {
+		panic("vfs: RegisterView renderer is nil")
+	}
+	viewsMu.Lock()
+	defer viewsMu.Unlock()
+	if _, dup := views[name]; dup {
+		panic("vfs: RegisterView called twice for view " + name)
+	}
+	views[name] = renderer
+}
+
+// LookupView returns the Renderer registered by name (case insensitive)
+func LookupView(name string) (Renderer, bool) {
+	viewsMu.RLock()
+	defer viewsMu.RUnlock()
+	r, ok := views[strings.ToLower(strings.TrimSpace(name))]
+	return r, ok
+}
+
+// ViewNames returns the sorted names of registered views
+func ViewNames() []string {
+	viewsMu.RLock()
+	defer viewsMu.RUnlock()
+	names := make([]string, 0, len(views))
+	for name := range views {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ViewBy renders VFS to w by the view registered as name
+func (v *VFS) ViewBy(w io.Writer, name string) error {
+	r, ok := LookupView(name)
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrUnknownView, name)
+	}
+	return r.Render(w, v, v.opt, sttyWidth)
+}
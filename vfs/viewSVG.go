@@ -0,0 +1,44 @@
+package vfs
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/shyang107/paw"
+	"github.com/sirupsen/logrus"
+)
+
+func init() {
+	RegisterView("svg", RendererFunc(func(w io.Writer, v *VFS, opt *VFSOption, width int) error {
+		return FprintSVG(w, v)
+	}))
+}
+
+// FprintSVG writes the SVG document reproducing the colored output of the view of VFSOption.ViewType to w (see paw.FprintANSISVG); the view is looked up by LookupView and rendered into a buffer which is always colored (see paw.NewColorWriter), regardless of VFSOption.ColorMode.
+func FprintSVG(w io.Writer, v *VFS) error {
+	paw.Logger.WithFields(logrus.Fields{"View type": v.opt.ViewType}).Debug("view...")
+	var (
+		buf = new(bytes.Buffer)
+		opt = paw.DefaultSVGOption
+	)
+	view, ok := LookupView(svgViewName(v.opt.ViewType))
+	if !ok {
+		view = builtinView(ViewList)
+	}
+	if err := view.Render(paw.NewColorWriter(buf, paw.ColorAlways), v, v.opt, sttyWidth); err != nil {
+		return err
+	}
+	opt.Title = v.RootDir().Path()
+	return paw.FprintANSISVG(w, buf.String(), &opt)
+}
+
+// svgViewName returns the name of built-in view of viewType rendered by the svg view, the extended, no-dirs and no-files options are kept by the view.
+func svgViewName(viewType ViewType) string {
+	viewType &^= ViewExtended | ViewNoDirs | ViewNoFiles
+	for name, vt := range ViewShortNameTypes {
+		if vt == viewType {
+			return name
+		}
+	}
+	return "list"
+}
@@ -101,15 +101,16 @@ func viewTableByTabulate(w io.Writer, rootdir *Dir, hasX, isViewNoDirs, isViewNo
 		}
 
 		var (
-			curnd, curnf  int
-			size          int64
-			vnitems       = nitems
-			sidx, renders string
-			rows          = make([][]string, 0)
-			xrows         [][]string
-			values        []string
-			wdname        int
-			t             *tabulate.Tabulate
+			curnd, curnf int
+			size         int64
+			vnitems      = nitems
+			sidx         string
+			rows         = make([][]string, 0)
+			xrows        [][]string
+			values       []string
+			wdname       int
+			sec          *section
+			render       func()
 		)
 		if isViewNoDirs || isViewNoFiles {
 			for _, de := range des {
@@ -122,11 +123,23 @@ func viewTableByTabulate(w io.Writer, rootdir *Dir, hasX, isViewNoDirs, isViewNo
 			}
 			curnd, curnf, size, nitems = 0, 0, 0, vnitems
 		}
+		render = func() {
+			t := tabulate.Create(rows)
+			t.EnableRawOut(_Widths)
+			t.SetHeaders(heads)
+			// t.SetAlign("left")
+			t.SetDenseMode()
+			fmt.Fprint(w, t.Render("simple"))
+			rows = make([][]string, 0)
+		}
+		sec = newSection(w, rootdir.opt.Grouping, "", wdstty)
+		sec.flush = render
 		// rows := make([][]string, 0, len(des))
 		for _, de := range des {
 			if isSkipViewItem(de, isViewNoDirs, isViewNoFiles, &nitems, &curnd, &curnf, &size) {
 				continue
 			}
+			sec.add(de)
 			count++
 			if de.IsDir() {
 				sidx = fmt.Sprintf("D%-[1]*[2]d", wdidx, tnd+curnd)
@@ -136,30 +149,27 @@ func viewTableByTabulate(w io.Writer, rootdir *Dir, hasX, isViewNoDirs, isViewNo
 			}
 			ViewFieldNo.SetValue(sidx)
 			values = vfields.GetValuesC(de)
-			wdname = paw.StringWidth(de.FieldC(ViewFieldName))
+			wdname = paw.StringWidth(paw.StripANSI(de.FieldC(ViewFieldName)))
 			if wdname < ViewFieldName.Width() {
 				values[len(values)-1] += paw.Spaces(ViewFieldName.Width() - wdname)
 			}
 			rows = append(rows, values)
 			if hasX {
-				xrows = vfields.XattibutesRowsC(de)
+				xrows = vfields.XattibutesRowsC(de, rootdir.opt.IsXattrValue)
 				rows = append(rows, xrows...)
 			}
 		}
-		t = tabulate.Create(rows)
-		t.EnableRawOut(_Widths)
-		t.SetHeaders(heads)
-		// t.SetAlign("left")
-		t.SetDenseMode()
-
-		renders = t.Render("simple")
-		fmt.Fprint(w, renders)
+		if rootdir.opt.Grouping.IsSection() {
+			sec.end()
+		} else {
+			render()
+		}
 
 		tnd += curnd
 		tnf += curnf
 		tsize += size
 		if rootdir.opt.Depth != 0 {
-			fmt.Fprintln(w, dirSummary("", curnd, curnf, size, wdstty))
+			fmt.Fprintln(w, dirTextSummary("", curnd, curnf, size, cur.textTotal(false), wdstty))
 			// cur.FprintlnSummaryC(w, "", wdstty, false)
 			if count < nitems {
 				FprintBanner(w, "", "-", wdstty)
@@ -172,7 +182,7 @@ func viewTableByTabulate(w io.Writer, rootdir *Dir, hasX, isViewNoDirs, isViewNo
 	}
 
 	FprintBanner(w, "", "=", wdstty)
-	fmt.Fprintln(w, totalSummary("", tnd, tnf, tsize, wdstty))
+	fmt.Fprintln(w, totalTextSummary("", tnd, tnf, tsize, rootdir.textTotal(true), wdstty))
 	// rootdir.FprintlnSummaryC(w, "", wdstty, true)
 
 	tabulate.MIN_PADDING = _MIN_PADDING
@@ -279,15 +289,16 @@ func viewTable(w io.Writer, rootdir *Dir, hasX, isViewNoDirs, isViewNoFiles bool
 			values, tf.FieldsColorString, tf.Colors = vfields.GetAllValues(de)
 			tf.PrintRow(values...)
 			if hasX {
-				xattrs := de.Xattibutes()
+				xsymbs, xattrs := extendedAttrs(de, rootdir.opt.IsXattrValue)
 				if len(xattrs) > 0 {
 					nfields := len(fields)
 					cxvalues := make([]string, nfields)
 					values := make([]interface{}, nfields)
-					for _, x := range xattrs {
-						values[nfields-1] = paw.XAttrSymbol + x
+					for i, x := range xattrs {
+						xsymb := " " + xsymbs[i]
+						values[nfields-1] = xsymb + x
 						cxvalues[nfields-1] =
-							paw.Cxbp.Sprint(paw.XAttrSymbol) +
+							paw.Cxbp.Sprint(xsymb) +
 								paw.Cxap.Sprint(x)
 						tf.FieldsColorString = cxvalues
 						tf.PrintRow(values...)
@@ -22,6 +22,8 @@ const (
 	ViewTable
 	// ViewClassify display type indicator by file names (like as `exa -F` or `exa --classify`) in PrintDir
 	ViewClassify
+	// ViewGrid is the option of grid view (like as `ls -C` or `ls -x`) using in PrintDir
+	ViewGrid
 	// PExtendView is the option to add extended attributes view using in PrintDir
 
 	_ViewList
@@ -50,11 +52,13 @@ const (
 	ViewLevelNoDirs    = ViewLevel | ViewNoDirs
 	ViewTableNoDirs    = ViewTable | ViewNoDirs
 	ViewClassifyNoDirs = ViewClassify | ViewNoDirs
+	ViewGridNoDirs     = ViewGrid | ViewNoDirs
 
 	ViewListNoFiles     = ViewList | ViewNoFiles
 	ViewLevelNoFiles    = ViewLevel | ViewNoFiles
 	ViewTableNoFiles    = ViewTable | ViewNoFiles
 	ViewClassifyNoFiles = ViewClassify | ViewNoFiles
+	ViewGridNoFiles     = ViewGrid | ViewNoFiles
 
 	ViewListXNoDirs  = ViewList | ViewExtended | ViewNoDirs
 	ViewLevelXNoDirs = ViewLevel | ViewExtended | ViewNoDirs
@@ -73,6 +77,7 @@ var (
 		ViewTable:           "Table view",
 		ViewListTree:        "List & Tree view",
 		ViewClassify:        "Classify view",
+		ViewGrid:            "Grid view",
 		ViewListX:           "Extended List view",
 		ViewTreeX:           "Extended Tree view",
 		ViewLevelX:          "Extended Level view",
@@ -82,10 +87,12 @@ var (
 		ViewLevelNoDirs:     "Level view (no dirs)",
 		ViewTableNoDirs:     "Table view (no dirs)",
 		ViewClassifyNoDirs:  "Classify view (no dirs)",
+		ViewGridNoDirs:      "Grid view (no dirs)",
 		ViewListNoFiles:     "List view (no files)",
 		ViewLevelNoFiles:    "Level view (no files)",
 		ViewTableNoFiles:    "Table view (no files)",
 		ViewClassifyNoFiles: "Classify view (no files)",
+		ViewGridNoFiles:     "Grid view (no files)",
 		ViewListXNoDirs:     "Extended List view (no dirs)",
 		ViewLevelXNoDirs:    "Extended Level view (no dirs)",
 		ViewTableXNoDirs:    "Extended Table view (no dirs)",
@@ -106,6 +113,7 @@ var (
 		ViewTable:           VFSViewTable,
 		ViewTableX:          VFSViewTable,
 		ViewClassify:        VFSViewClassify,
+		ViewGrid:            VFSViewGrid,
 		ViewListNoDirs:      VFSViewList,
 		ViewListXNoDirs:     VFSViewList,
 		ViewLevelNoDirs:     VFSViewLevel,
@@ -113,6 +121,7 @@ var (
 		ViewTableNoDirs:     VFSViewTable,
 		ViewTableXNoDirs:    VFSViewTable,
 		ViewClassifyNoDirs:  VFSViewClassify,
+		ViewGridNoDirs:      VFSViewGrid,
 		ViewListNoFiles:     VFSViewList,
 		ViewListXNoFiles:    VFSViewList,
 		ViewLevelNoFiles:    VFSViewLevel,
@@ -120,6 +129,7 @@ var (
 		ViewTableNoFiles:    VFSViewTable,
 		ViewTableXNoFiles:   VFSViewTable,
 		ViewClassifyNoFiles: VFSViewClassify,
+		ViewGridNoFiles:     VFSViewGrid,
 	}
 )
 
@@ -0,0 +1,85 @@
+package vfs
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// XattrValueMaxLen is the maximum number of bytes of value of extended attribute shown in extended views, the rest is truncated.
+var XattrValueMaxLen = 32
+
+// XattrValueS returns the printable string of value of extended attribute.
+//
+// Text value is quoted and binary value is hex-escaped (e.g. "\x62\x70"), both are truncated to XattrValueMaxLen bytes with the original length.
+func XattrValueS(value []byte) string {
+	n := len(value)
+	if isXattrText(value) {
+		text := strings.TrimRight(string(value), "\x00")
+		if len(text) <= XattrValueMaxLen {
+			return fmt.Sprintf("%q", text)
+		}
+		// truncate at rune boundary
+		end := XattrValueMaxLen
+		for end > 0 && !utf8.RuneStart(text[end]) {
+			end--
+		}
+		return fmt.Sprintf("%q… (len %d)", text[:end], n)
+	}
+	var sb strings.Builder
+	for i, b := range value {
+		if i == XattrValueMaxLen {
+			sb.WriteString("…")
+			break
+		}
+		fmt.Fprintf(&sb, "\\x%02x", b)
+	}
+	return fmt.Sprintf("%s (len %d)", sb.String(), n)
+}
+
+// isXattrText returns true if value is valid UTF-8 without control characters (except a trailing NUL)
+func isXattrText(value []byte) bool {
+	text := strings.TrimRight(string(value), "\x00")
+	if len(text) == 0 || !utf8.ValidString(text) {
+		return false
+	}
+	for _, r := range text {
+		if !unicode.IsPrint(r) && r != '\t' && r != '\n' {
+			return false
+		}
+	}
+	return true
+}
+
+// extendedAttrs returns the extended attributes (names or values) and the full ACL entries of de with their symbols, used in extended views
+func extendedAttrs(de DirEntryX, isValue bool) (symbs, attrs []string) {
+	xattrs, acl := de.Xattibutes(), de.ACL()
+	if isValue {
+		xattrs = de.XattibuteValues()
+	}
+	symbs = make([]string, 0, len(xattrs)+len(acl))
+	attrs = make([]string, 0, len(xattrs)+len(acl))
+	for _, x := range xattrs {
+		symbs = append(symbs, "@ ")
+		attrs = append(attrs, x)
+	}
+	for _, a := range acl {
+		symbs = append(symbs, ACLSymbol+" ")
+		attrs = append(attrs, a)
+	}
+	return symbs, attrs
+}
+
+// hasXattrOf returns true if de has any extended attribute matching one of patterns (see filepath.Match, e.g. "user.*")
+func hasXattrOf(de DirEntryX, patterns []string) bool {
+	for _, name := range de.XattrNames() {
+		for _, pattern := range patterns {
+			if ok, _ := filepath.Match(pattern, name); ok {
+				return true
+			}
+		}
+	}
+	return false
+}